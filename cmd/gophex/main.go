@@ -11,6 +11,7 @@ import (
 	"github.com/buildwithhp/gophex/internal/infrastructure/generator"
 	"github.com/buildwithhp/gophex/internal/infrastructure/repository"
 	"github.com/buildwithhp/gophex/internal/shared/config"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
 	"github.com/buildwithhp/gophex/internal/shared/logger"
 	"github.com/buildwithhp/gophex/internal/shared/template"
 	"github.com/buildwithhp/gophex/internal/ui/cli"
@@ -27,8 +28,8 @@ func main() {
 
 	// Run the application
 	if err := run(ctx); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		failure.Report(os.Stderr, err)
+		os.Exit(failure.ExitCode(err))
 	}
 }
 
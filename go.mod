@@ -2,11 +2,14 @@ module github.com/buildwithhp/gophex
 
 go 1.24.5
 
-require github.com/AlecAivazis/survey/v2 v2.3.7
+require (
+	github.com/AlecAivazis/survey/v2 v2.3.7
+	github.com/dolmen-go/kittyimg v0.0.0-20250610224728-874967bd8ea4
+	golang.org/x/term v0.29.0
+)
 
 require (
 	github.com/aybabtme/rgbterm v0.0.0-20170906152045-cc83f3b3ce59 // indirect
-	github.com/dolmen-go/kittyimg v0.0.0-20250610224728-874967bd8ea4 // indirect
 	github.com/kballard/go-shellquote v0.0.0-20180428030007-95032a82bc51 // indirect
 	github.com/mattn/go-colorable v0.1.2 // indirect
 	github.com/mattn/go-isatty v0.0.8 // indirect
@@ -15,7 +18,6 @@ require (
 	github.com/qeesung/image2ascii v1.0.1 // indirect
 	github.com/wayneashleyberry/terminal-dimensions v1.1.0 // indirect
 	golang.org/x/sys v0.30.0 // indirect
-	golang.org/x/term v0.29.0 // indirect
 	golang.org/x/text v0.4.0 // indirect
 	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
@@ -0,0 +1,397 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+	"golang.org/x/term"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// accessibleFlag is the command-line flag that turns on accessible mode
+const accessibleFlag = "--accessible"
+
+// accessibleMode replaces survey's cursor-driven prompts with numbered questions answered
+// by typing, which screen readers can follow. It is set by --accessible or GOPHEX_ACCESSIBLE.
+var accessibleMode = accessibleFromEnv()
+
+// accessibleFromEnv reports whether GOPHEX_ACCESSIBLE asks for accessible mode
+func accessibleFromEnv() bool {
+	switch strings.ToLower(utils.GetEnvWithDefault("GOPHEX_ACCESSIBLE", "")) {
+	case "1", "true", "yes", "on":
+		return true
+	default:
+		return false
+	}
+}
+
+// ParseGlobalFlags applies flags that may precede any subcommand, such as --accessible
+// and --defaults, and returns the remaining arguments
+func ParseGlobalFlags(args []string) ([]string, error) {
+	var remaining []string
+	for _, arg := range args {
+		if arg == accessibleFlag {
+			accessibleMode = true
+			continue
+		}
+		handled, err := parseDefaultsFlag(arg)
+		if err != nil {
+			return nil, err
+		}
+		if handled {
+			continue
+		}
+		remaining = append(remaining, arg)
+	}
+	return remaining, nil
+}
+
+// accessiblePrompter asks survey prompts as plain numbered questions: options are listed
+// with numbers, choices and confirmations are typed, and nothing redraws the screen
+type accessiblePrompter struct {
+	in         *bufio.Reader
+	out        io.Writer
+	question   int
+	readSecret func() (string, error)
+}
+
+// stdioPrompter is the accessible prompter bound to the terminal; it keeps one reader so
+// input typed ahead is not lost between questions
+var stdioPrompter = newAccessiblePrompter(os.Stdin, os.Stdout)
+
+// newAccessiblePrompter creates an accessible prompter. Passwords are read without echo
+// when in is a terminal.
+func newAccessiblePrompter(in io.Reader, out io.Writer) *accessiblePrompter {
+	p := &accessiblePrompter{in: bufio.NewReader(in), out: out}
+	p.readSecret = p.readLine
+	if file, ok := in.(*os.File); ok && term.IsTerminal(int(file.Fd())) {
+		p.readSecret = func() (string, error) {
+			secret, err := term.ReadPassword(int(file.Fd()))
+			fmt.Fprintln(out)
+			return string(secret), err
+		}
+	}
+	return p
+}
+
+// askOne asks a survey prompt, in accessible form when accessible mode is on, or answers
+// it with its default under --defaults and --yes. Every wizard prompt goes through here.
+func askOne(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	if autoAnswer != nil {
+		return autoAnswer.ask(prompt, response, opts...)
+	}
+	if !accessibleMode {
+		return survey.AskOne(prompt, response, opts...)
+	}
+	return stdioPrompter.ask(prompt, response, opts...)
+}
+
+// ask asks one prompt, repeating the question until the answer passes the prompt's validators
+func (p *accessiblePrompter) ask(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	var options survey.AskOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	p.question++
+	for {
+		answer, err := p.answer(prompt)
+		if err != nil {
+			return err
+		}
+
+		if err := validateAnswer(answer, options.Validators); err != nil {
+			fmt.Fprintf(p.out, "❌ %v. Please try again.\n", err)
+			continue
+		}
+
+		return core.WriteAnswer(response, "", answer)
+	}
+}
+
+// validateAnswer runs survey validators against an answer
+func validateAnswer(answer interface{}, validators []survey.Validator) error {
+	for _, validator := range validators {
+		if err := validator(answer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// answer asks the prompt once and converts the typed reply into survey's answer type
+func (p *accessiblePrompter) answer(prompt survey.Prompt) (interface{}, error) {
+	switch q := prompt.(type) {
+	case *survey.Select:
+		return p.askSelect(q)
+	case *survey.MultiSelect:
+		return p.askMultiSelect(q)
+	case *survey.Confirm:
+		return p.askConfirm(q)
+	case *survey.Input:
+		return p.askInput(q)
+	case *survey.Password:
+		return p.askPassword(q)
+	default:
+		return nil, fmt.Errorf("accessible mode does not support %T prompts", prompt)
+	}
+}
+
+// askSelect lists the options with numbers and reads the chosen number
+func (p *accessiblePrompter) askSelect(q *survey.Select) (interface{}, error) {
+	defaultIndex := optionIndex(q.Options, q.Default)
+
+	p.printQuestion(q.Message)
+	p.printOptions(q.Options, q.Description)
+	hint := fmt.Sprintf("Enter a number from 1 to %d", len(q.Options))
+	if defaultIndex >= 0 {
+		hint += fmt.Sprintf(", or press Enter for %d", defaultIndex+1)
+	}
+
+	for {
+		reply, err := p.prompt(hint, q.Help)
+		if err != nil {
+			return nil, err
+		}
+
+		if reply == "" && defaultIndex >= 0 {
+			return core.OptionAnswer{Value: q.Options[defaultIndex], Index: defaultIndex}, nil
+		}
+		if index, ok := parseOptionNumber(reply, q.Options); ok {
+			return core.OptionAnswer{Value: q.Options[index], Index: index}, nil
+		}
+		fmt.Fprintf(p.out, "❌ %q is not one of the options. Please try again.\n", reply)
+	}
+}
+
+// askMultiSelect lists the options with numbers and reads a comma-separated list of numbers
+func (p *accessiblePrompter) askMultiSelect(q *survey.MultiSelect) (interface{}, error) {
+	defaults := defaultOptionIndexes(q.Options, q.Default)
+
+	p.printQuestion(q.Message)
+	p.printOptions(q.Options, q.Description)
+	hint := "Enter the numbers of your choices separated by commas"
+	if len(defaults) > 0 {
+		numbers := make([]string, len(defaults))
+		for i, index := range defaults {
+			numbers[i] = strconv.Itoa(index + 1)
+		}
+		hint += ", or press Enter for " + strings.Join(numbers, ",")
+	}
+
+	for {
+		reply, err := p.prompt(hint, q.Help)
+		if err != nil {
+			return nil, err
+		}
+
+		indexes := defaults
+		if reply != "" {
+			var invalid string
+			indexes, invalid = parseOptionNumbers(reply, q.Options)
+			if invalid != "" {
+				fmt.Fprintf(p.out, "❌ %q is not one of the options. Please try again.\n", invalid)
+				continue
+			}
+		}
+
+		answers := []core.OptionAnswer{}
+		for _, index := range indexes {
+			answers = append(answers, core.OptionAnswer{Value: q.Options[index], Index: index})
+		}
+		return answers, nil
+	}
+}
+
+// askConfirm reads a typed yes or no
+func (p *accessiblePrompter) askConfirm(q *survey.Confirm) (interface{}, error) {
+	p.printQuestion(q.Message)
+	hint := "Type yes or no, or press Enter for no"
+	if q.Default {
+		hint = "Type yes or no, or press Enter for yes"
+	}
+
+	for {
+		reply, err := p.prompt(hint, q.Help)
+		if err != nil {
+			return nil, err
+		}
+
+		switch strings.ToLower(reply) {
+		case "":
+			return q.Default, nil
+		case "y", "yes":
+			return true, nil
+		case "n", "no":
+			return false, nil
+		}
+		fmt.Fprintln(p.out, "❌ Please type yes or no.")
+	}
+}
+
+// askInput reads a line of text
+func (p *accessiblePrompter) askInput(q *survey.Input) (interface{}, error) {
+	p.printQuestion(q.Message)
+	hint := "Type your answer"
+	if q.Default != "" {
+		hint += fmt.Sprintf(", or press Enter for %q", q.Default)
+	}
+
+	reply, err := p.prompt(hint, q.Help)
+	if err != nil {
+		return nil, err
+	}
+	if reply == "" {
+		return q.Default, nil
+	}
+	return reply, nil
+}
+
+// askPassword reads a secret without echoing it
+func (p *accessiblePrompter) askPassword(q *survey.Password) (interface{}, error) {
+	p.printQuestion(q.Message)
+	fmt.Fprint(p.out, "Type your answer; it will not be shown: ")
+	return p.readSecret()
+}
+
+// printQuestion announces the next question with its number
+func (p *accessiblePrompter) printQuestion(message string) {
+	fmt.Fprintf(p.out, "\nQuestion %d: %s\n", p.question, strings.TrimSpace(message))
+}
+
+// printOptions lists options as "1. option", one per line
+func (p *accessiblePrompter) printOptions(options []string, describe func(string, int) string) {
+	for i, option := range options {
+		line := fmt.Sprintf("  %d. %s", i+1, option)
+		if describe != nil {
+			if description := describe(option, i); description != "" {
+				line += " - " + description
+			}
+		}
+		fmt.Fprintln(p.out, line)
+	}
+}
+
+// prompt shows the hint and reads a reply, showing help instead when the reply is "?"
+func (p *accessiblePrompter) prompt(hint, help string) (string, error) {
+	if help != "" {
+		hint += ", or ? for help"
+	}
+
+	for {
+		fmt.Fprintf(p.out, "%s: ", hint)
+		reply, err := p.readLine()
+		if err != nil {
+			return "", err
+		}
+		if reply == "?" && help != "" {
+			fmt.Fprintf(p.out, "Help: %s\n", help)
+			continue
+		}
+		return reply, nil
+	}
+}
+
+// readLine reads one trimmed line of input
+func (p *accessiblePrompter) readLine() (string, error) {
+	line, err := p.in.ReadString('\n')
+	if err != nil && (err != io.EOF || line == "") {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// parseOptionNumber converts a typed option number, the option text, or the label before
+// " - " (so "yes" picks "Yes - Install dependencies now") to an index
+func parseOptionNumber(reply string, options []string) (int, bool) {
+	if number, err := strconv.Atoi(reply); err == nil {
+		return number - 1, number >= 1 && number <= len(options)
+	}
+
+	labelMatch := -1
+	for i, option := range options {
+		if strings.EqualFold(option, reply) {
+			return i, true
+		}
+		label := strings.TrimSpace(strings.SplitN(option, " - ", 2)[0])
+		if strings.EqualFold(label, reply) {
+			if labelMatch >= 0 {
+				return -1, false // ambiguous
+			}
+			labelMatch = i
+		}
+	}
+	return labelMatch, labelMatch >= 0
+}
+
+// parseOptionNumbers converts a comma-separated list of option numbers to indexes,
+// returning the first entry that is not an option
+func parseOptionNumbers(reply string, options []string) ([]int, string) {
+	var indexes []int
+	seen := make(map[int]bool)
+	for _, part := range strings.Split(reply, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+		index, ok := parseOptionNumber(part, options)
+		if !ok {
+			return nil, part
+		}
+		if !seen[index] {
+			seen[index] = true
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes, ""
+}
+
+// optionIndex returns the index of a survey default given as an option or an index, or -1
+func optionIndex(options []string, def interface{}) int {
+	switch value := def.(type) {
+	case int:
+		if value >= 0 && value < len(options) {
+			return value
+		}
+	case string:
+		for i, option := range options {
+			if option == value {
+				return i
+			}
+		}
+	}
+	return -1
+}
+
+// defaultOptionIndexes returns the indexes of a MultiSelect default
+func defaultOptionIndexes(options []string, def interface{}) []int {
+	var indexes []int
+	switch values := def.(type) {
+	case []string:
+		for _, value := range values {
+			if index := optionIndex(options, value); index >= 0 {
+				indexes = append(indexes, index)
+			}
+		}
+	case []int:
+		for _, value := range values {
+			if index := optionIndex(options, value); index >= 0 {
+				indexes = append(indexes, index)
+			}
+		}
+	default:
+		if index := optionIndex(options, def); index >= 0 {
+			indexes = append(indexes, index)
+		}
+	}
+	return indexes
+}
@@ -0,0 +1,154 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+func TestAccessiblePrompterSelect(t *testing.T) {
+	options := []string{"Yes - Install dependencies now", "No - Skip", "Quit"}
+
+	tests := []struct {
+		name     string
+		input    string
+		def      interface{}
+		expected string
+	}{
+		{"number", "2\n", nil, "No - Skip"},
+		{"default", "\n", "Quit", "Quit"},
+		{"default index", "\n", 1, "No - Skip"},
+		{"label", "yes\n", nil, "Yes - Install dependencies now"},
+		{"full option text", "quit\n", nil, "Quit"},
+		{"retries invalid answers", "7\nmaybe\n1\n", nil, "Yes - Install dependencies now"},
+		{"help", "?\n3\n", nil, "Quit"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			p := newAccessiblePrompter(strings.NewReader(tt.input), &out)
+
+			var answer string
+			prompt := &survey.Select{Message: "Install dependencies?", Options: options, Default: tt.def, Help: "Runs go mod tidy"}
+			if err := p.ask(prompt, &answer); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if answer != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, answer)
+			}
+			if !strings.Contains(out.String(), "Question 1: Install dependencies?\n  1. Yes - Install dependencies now\n") {
+				t.Errorf("Expected a numbered question with numbered options, got %q", out.String())
+			}
+		})
+	}
+}
+
+func TestAccessiblePrompterSelectIndex(t *testing.T) {
+	p := newAccessiblePrompter(strings.NewReader("3\n"), io.Discard)
+
+	var index int
+	if err := p.ask(&survey.Select{Message: "Level", Options: []string{"all", "warn", "error"}}, &index); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if index != 2 {
+		t.Errorf("Expected index 2, got %d", index)
+	}
+}
+
+func TestAccessiblePrompterMultiSelect(t *testing.T) {
+	options := []string{"domain", "repository", "http"}
+
+	tests := []struct {
+		name     string
+		input    string
+		expected []string
+	}{
+		{"numbers", "1, 3\n", []string{"domain", "http"}},
+		{"defaults", "\n", []string{"domain", "repository"}},
+		{"retries invalid numbers", "1,9\n2\n", []string{"repository"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := newAccessiblePrompter(strings.NewReader(tt.input), io.Discard)
+
+			var answer []string
+			prompt := &survey.MultiSelect{Message: "Layers", Options: options, Default: []string{"domain", "repository"}}
+			if err := p.ask(prompt, &answer); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(answer, tt.expected) {
+				t.Errorf("Expected %v, got %v", tt.expected, answer)
+			}
+		})
+	}
+}
+
+func TestAccessiblePrompterInputAndConfirm(t *testing.T) {
+	var out bytes.Buffer
+	p := newAccessiblePrompter(strings.NewReader("\n\nmyapi\nmaybe\nyes\n\ns3cret\n"), &out)
+
+	var name string
+	if err := p.ask(&survey.Input{Message: "Project name"}, &name, survey.WithValidator(survey.Required)); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if name != "myapi" {
+		t.Errorf("Expected myapi after an empty answer was rejected, got %q", name)
+	}
+	if !strings.Contains(out.String(), "Value is required") {
+		t.Errorf("Expected the validator message, got %q", out.String())
+	}
+
+	var confirmed bool
+	if err := p.ask(&survey.Confirm{Message: "Continue?"}, &confirmed); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !confirmed {
+		t.Error("Expected typed yes to confirm")
+	}
+
+	var port string
+	if err := p.ask(&survey.Input{Message: "Port", Default: "8080"}, &port); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if port != "8080" {
+		t.Errorf("Expected default 8080, got %q", port)
+	}
+
+	var password string
+	if err := p.ask(&survey.Password{Message: "Password"}, &password); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if password != "s3cret" {
+		t.Errorf("Expected s3cret, got %q", password)
+	}
+
+	if !strings.Contains(out.String(), "Question 4: Password") {
+		t.Errorf("Expected questions to be numbered in order, got %q", out.String())
+	}
+
+	if err := p.ask(&survey.Input{Message: "More"}, &name); err != io.EOF {
+		t.Errorf("Expected EOF at the end of input, got %v", err)
+	}
+}
+
+func TestParseGlobalFlags(t *testing.T) {
+	defer func(enabled bool) { accessibleMode = enabled }(accessibleMode)
+	accessibleMode = false
+
+	remaining, err := ParseGlobalFlags([]string{"--accessible", "inspect", "endpoints"})
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !accessibleMode {
+		t.Error("Expected --accessible to enable accessible mode")
+	}
+	if !reflect.DeepEqual(remaining, []string{"inspect", "endpoints"}) {
+		t.Errorf("Expected [inspect endpoints], got %v", remaining)
+	}
+}
@@ -0,0 +1,260 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// defaultLogBufferLines is how many recent log lines are kept in memory per application
+const defaultLogBufferLines = 2000
+
+// Log streams and levels recognised by the log viewer, from least to most severe
+const (
+	streamStdout = "stdout"
+	streamStderr = "stderr"
+	streamGophex = "gophex"
+)
+
+var logLevels = []string{"DEBUG", "INFO", "WARN", "ERROR"}
+
+// textLevelPattern matches level=INFO style attributes written by slog's text handler and logfmt loggers
+var textLevelPattern = regexp.MustCompile(`(?i)\blevel=("?)(debug|info|warn|warning|error|fatal|panic)\b`)
+
+// LogLine is a single captured line of application output
+type LogLine struct {
+	Seq    int
+	Time   time.Time
+	Stream string
+	Level  string
+	Text   string
+}
+
+// LogBuffer captures application output in a fixed-size ring buffer and mirrors it to a log file
+type LogBuffer struct {
+	mutex    sync.Mutex
+	lines    []LogLine
+	capacity int
+	next     int
+	seq      int
+	partial  map[string][]byte
+	file     io.WriteCloser
+	path     string
+	closed   bool
+}
+
+// NewLogBuffer creates a log buffer keeping the last capacity lines; file may be nil
+func NewLogBuffer(capacity int, file io.WriteCloser) *LogBuffer {
+	if capacity <= 0 {
+		capacity = defaultLogBufferLines
+	}
+	return &LogBuffer{
+		capacity: capacity,
+		partial:  make(map[string][]byte),
+		file:     file,
+	}
+}
+
+// NewAppLogBuffer creates a log buffer for an application started from the menu,
+// mirrored to .gophex/logs/<name>.log inside the project
+func NewAppLogBuffer(projectPath, name string) (*LogBuffer, error) {
+	logDir := filepath.Join(projectPath, ".gophex", "logs")
+	if err := os.MkdirAll(logDir, 0755); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
+	}
+
+	logPath := filepath.Join(logDir, name+".log")
+	file, err := os.Create(logPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create log file: %w", err)
+	}
+
+	buffer := NewLogBuffer(defaultLogBufferLines, file)
+	buffer.path = logPath
+	return buffer, nil
+}
+
+// Path returns the file the log is mirrored to, if any
+func (b *LogBuffer) Path() string {
+	return b.path
+}
+
+// Writer returns an io.Writer that records output for the given stream
+func (b *LogBuffer) Writer(stream string) io.Writer {
+	return &logWriter{buffer: b, stream: stream}
+}
+
+// logWriter feeds one output stream of a process into a LogBuffer
+type logWriter struct {
+	buffer *LogBuffer
+	stream string
+}
+
+// Write implements io.Writer
+func (w *logWriter) Write(p []byte) (int, error) {
+	w.buffer.write(w.stream, p)
+	return len(p), nil
+}
+
+// write splits output into lines, holding back an unterminated trailing line
+func (b *LogBuffer) write(stream string, p []byte) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	data := append(b.partial[stream], p...)
+	for {
+		i := bytes.IndexByte(data, '\n')
+		if i < 0 {
+			break
+		}
+		b.appendLine(stream, string(bytes.TrimRight(data[:i], "\r")))
+		data = data[i+1:]
+	}
+	b.partial[stream] = append([]byte(nil), data...)
+}
+
+// appendLine stores a complete line; the caller must hold the mutex
+func (b *LogBuffer) appendLine(stream, text string) {
+	b.seq++
+	line := LogLine{
+		Seq:    b.seq,
+		Time:   time.Now(),
+		Stream: stream,
+		Level:  detectLogLevel(text),
+		Text:   text,
+	}
+
+	if len(b.lines) < b.capacity {
+		b.lines = append(b.lines, line)
+	} else {
+		b.lines[b.next] = line
+	}
+	b.next = (b.next + 1) % b.capacity
+
+	if b.file != nil && !b.closed {
+		fmt.Fprintf(b.file, "%s %-6s %s\n", line.Time.Format(time.RFC3339), stream, text)
+	}
+}
+
+// Finish records how the application exited, flushes pending output and closes the log file
+func (b *LogBuffer) Finish(exitErr error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.closed {
+		return
+	}
+
+	for _, stream := range []string{streamStdout, streamStderr} {
+		if len(b.partial[stream]) > 0 {
+			b.appendLine(stream, string(b.partial[stream]))
+			b.partial[stream] = nil
+		}
+	}
+
+	if exitErr != nil {
+		b.appendLine(streamGophex, fmt.Sprintf("level=ERROR application exited: %v", exitErr))
+	} else {
+		b.appendLine(streamGophex, "level=INFO application exited")
+	}
+
+	if b.file != nil {
+		b.file.Close()
+	}
+	b.closed = true
+}
+
+// Running reports whether the buffer is still receiving output
+func (b *LogBuffer) Running() bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	return !b.closed
+}
+
+// Lines returns the buffered lines after sequence number since whose level is at least minLevel, oldest first
+func (b *LogBuffer) Lines(since int, minLevel string) []LogLine {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	minRank := logLevelRank(minLevel)
+	var result []LogLine
+
+	start := 0
+	if len(b.lines) == b.capacity {
+		start = b.next
+	}
+	for i := 0; i < len(b.lines); i++ {
+		line := b.lines[(start+i)%len(b.lines)]
+		if line.Seq > since && logLevelRank(line.Level) >= minRank {
+			result = append(result, line)
+		}
+	}
+
+	return result
+}
+
+// detectLogLevel determines the level of a log line from JSON, key=value or plain-text output
+func detectLogLevel(text string) string {
+	trimmed := strings.TrimSpace(text)
+
+	if strings.HasPrefix(trimmed, "{") {
+		var entry struct {
+			Level string `json:"level"`
+		}
+		if err := json.Unmarshal([]byte(trimmed), &entry); err == nil && entry.Level != "" {
+			return normalizeLogLevel(entry.Level)
+		}
+	}
+
+	if match := textLevelPattern.FindStringSubmatch(trimmed); match != nil {
+		return normalizeLogLevel(match[2])
+	}
+
+	upper := strings.ToUpper(trimmed)
+	switch {
+	case strings.HasPrefix(upper, "PANIC"), strings.Contains(upper, "FATAL"), strings.Contains(upper, "[ERROR]"), strings.HasPrefix(upper, "ERROR"):
+		return "ERROR"
+	case strings.Contains(upper, "[WARN"), strings.HasPrefix(upper, "WARN"):
+		return "WARN"
+	case strings.Contains(upper, "[DEBUG]"), strings.Contains(upper, "-DEBUG]"), strings.HasPrefix(upper, "DEBUG"):
+		return "DEBUG"
+	default:
+		return "INFO"
+	}
+}
+
+// normalizeLogLevel maps level names such as "warning" or "fatal" onto the viewer's levels
+func normalizeLogLevel(level string) string {
+	switch strings.ToUpper(level) {
+	case "DEBUG", "TRACE":
+		return "DEBUG"
+	case "WARN", "WARNING":
+		return "WARN"
+	case "ERROR", "FATAL", "PANIC":
+		return "ERROR"
+	default:
+		return "INFO"
+	}
+}
+
+// logLevelRank returns the severity index of a level; unknown levels rank as DEBUG
+func logLevelRank(level string) int {
+	for i, name := range logLevels {
+		if name == level {
+			return i
+		}
+	}
+	return 0
+}
+
+// formatLogLine renders a captured line for the terminal
+func formatLogLine(line LogLine) string {
+	return fmt.Sprintf("%s %-5s %s", line.Time.Format("15:04:05"), line.Level, line.Text)
+}
@@ -0,0 +1,138 @@
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDetectLogLevel(t *testing.T) {
+	tests := []struct {
+		line     string
+		expected string
+	}{
+		{`{"time":"2025-01-01T00:00:00Z","level":"INFO","msg":"Starting server"}`, "INFO"},
+		{`{"level":"ERROR","msg":"database unreachable"}`, "ERROR"},
+		{`{"level":"warn","msg":"slow query"}`, "WARN"},
+		{`time=2025-01-01T00:00:00Z level=DEBUG msg="cache miss"`, "DEBUG"},
+		{`level=warning msg="deprecated config"`, "WARN"},
+		{"[GIN-debug] GET /api/v1/health --> handler", "DEBUG"},
+		{"panic: runtime error: index out of range", "ERROR"},
+		{"2025/01/01 00:00:00 Failed to load config: FATAL", "ERROR"},
+		{"Server listening on :8080", "INFO"},
+	}
+
+	for _, test := range tests {
+		if result := detectLogLevel(test.line); result != test.expected {
+			t.Errorf("detectLogLevel(%q): expected %s, got %s", test.line, test.expected, result)
+		}
+	}
+}
+
+func TestLogBufferSplitsLines(t *testing.T) {
+	var file bytes.Buffer
+	logs := NewLogBuffer(10, nopWriteCloser{&file})
+
+	stdout := logs.Writer(streamStdout)
+	fmt.Fprint(stdout, "first line\nsecond ")
+	fmt.Fprint(stdout, "line\r\nunterminated")
+
+	lines := logs.Lines(0, "DEBUG")
+	if len(lines) != 2 {
+		t.Fatalf("Expected 2 complete lines, got %d", len(lines))
+	}
+	if lines[1].Text != "second line" {
+		t.Errorf("Expected 'second line', got %q", lines[1].Text)
+	}
+
+	logs.Finish(errors.New("exit status 1"))
+	lines = logs.Lines(0, "DEBUG")
+	if len(lines) != 4 || lines[2].Text != "unterminated" {
+		t.Fatalf("Expected the unterminated line to be flushed on exit, got %v", lines)
+	}
+	if lines[3].Stream != streamGophex || lines[3].Level != "ERROR" {
+		t.Errorf("Expected an ERROR exit line, got %+v", lines[3])
+	}
+	if logs.Running() {
+		t.Error("Expected buffer to stop running after Finish")
+	}
+	if !strings.Contains(file.String(), "stdout first line") {
+		t.Errorf("Expected output to be mirrored to the log file, got %q", file.String())
+	}
+}
+
+func TestLogBufferRingAndFilter(t *testing.T) {
+	logs := NewLogBuffer(3, nil)
+	stderr := logs.Writer(streamStderr)
+	for i := 1; i <= 5; i++ {
+		level := "INFO"
+		if i%2 == 0 {
+			level = "ERROR"
+		}
+		fmt.Fprintf(stderr, "level=%s line %d\n", level, i)
+	}
+
+	lines := logs.Lines(0, "DEBUG")
+	if len(lines) != 3 {
+		t.Fatalf("Expected ring buffer to keep 3 lines, got %d", len(lines))
+	}
+	if lines[0].Seq != 3 || lines[2].Seq != 5 {
+		t.Errorf("Expected lines 3-5 in order, got %d-%d", lines[0].Seq, lines[2].Seq)
+	}
+
+	errorsOnly := logs.Lines(0, "ERROR")
+	if len(errorsOnly) != 1 || errorsOnly[0].Seq != 4 {
+		t.Errorf("Expected only line 4 at ERROR level, got %v", errorsOnly)
+	}
+
+	if newer := logs.Lines(4, "DEBUG"); len(newer) != 1 || newer[0].Seq != 5 {
+		t.Errorf("Expected only line 5 after sequence 4, got %v", newer)
+	}
+}
+
+func TestFollowLogsStopsWhenApplicationExits(t *testing.T) {
+	logs := NewLogBuffer(10, nil)
+	stdout := logs.Writer(streamStdout)
+	fmt.Fprintln(stdout, "level=INFO starting")
+
+	stop := make(chan struct{})
+	var out bytes.Buffer
+	done := make(chan struct{})
+	go func() {
+		followLogs(&out, logs, "INFO", stop)
+		close(done)
+	}()
+
+	fmt.Fprintln(stdout, "level=DEBUG hidden")
+	fmt.Fprintln(stdout, "level=ERROR boom")
+	logs.Finish(nil)
+
+	time.Sleep(3 * logFollowInterval)
+	close(stop)
+
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("followLogs did not return after stop")
+	}
+
+	output := out.String()
+	for _, expected := range []string{"starting", "boom", "application exited", "Application has exited"} {
+		if !strings.Contains(output, expected) {
+			t.Errorf("Expected follow output to contain %q, got:\n%s", expected, output)
+		}
+	}
+	if strings.Contains(output, "hidden") {
+		t.Error("Expected DEBUG lines to be filtered out")
+	}
+}
+
+// nopWriteCloser adapts a bytes.Buffer to io.WriteCloser
+type nopWriteCloser struct {
+	*bytes.Buffer
+}
+
+func (nopWriteCloser) Close() error { return nil }
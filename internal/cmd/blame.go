@@ -0,0 +1,212 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+// blameUsage describes the blame subcommand
+const blameUsage = "gophex blame [FILE] [--format table|json] [--path DIR]"
+
+// fileOrigin is where a generated file came from and whether it changed since
+type fileOrigin struct {
+	File          string `json:"file"`
+	Template      string `json:"template"`
+	GophexVersion string `json:"gophex_version"`
+	GeneratedAt   string `json:"generated_at"`
+	Status        string `json:"status"`
+}
+
+// templateUsage counts the files one template set and Gophex version produced in a project
+type templateUsage struct {
+	Template      string `json:"template"`
+	GophexVersion string `json:"gophex_version"`
+	Files         int    `json:"files"`
+	Modified      int    `json:"modified"`
+	Missing       int    `json:"missing"`
+}
+
+// runBlame implements "gophex blame [FILE] [--format table|json] [--path DIR]". With a file it
+// shows the file's origin; without one it summarizes template usage across the project.
+// Everything is read from gophex.md, so nothing leaves the machine.
+func runBlame(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("blame", flag.ContinueOnError)
+	format := fs.String("format", "table", "output format: table or json")
+	projectPath := fs.String("path", ".", "project directory containing gophex.md")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) > 1 {
+		return usageError(blameUsage)
+	}
+
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(*projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project metadata: %w", err)
+	}
+
+	var data interface{}
+	if len(positional) == 1 {
+		origin, err := blameFile(*projectPath, positional[0], projectMetadata.Files)
+		if err != nil {
+			return err
+		}
+		data = origin
+	} else {
+		usage, err := summarizeTemplateUsage(*projectPath, projectMetadata.Files)
+		if err != nil {
+			return err
+		}
+		data = usage
+	}
+
+	if strings.ToLower(*format) == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	}
+
+	return writeBlameTable(out, data)
+}
+
+// blameFile looks up a file in the manifest. file may be relative to the project or to the
+// working directory, so "gophex blame internal/config/config.go --path myapi" works too.
+func blameFile(projectPath, file string, files map[string]metadata.FileRecord) (*fileOrigin, error) {
+	relPath, err := projectRelativePath(projectPath, file)
+	if err != nil {
+		return nil, err
+	}
+
+	record, ok := files[relPath]
+	if !ok {
+		return nil, failure.User(fmt.Errorf("%s is not in the gophex.md file manifest: it was not generated by Gophex", relPath), "")
+	}
+
+	status, err := metadata.FileStatus(projectPath, relPath, record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &fileOrigin{
+		File:          relPath,
+		Template:      record.Template,
+		GophexVersion: record.GophexVersion,
+		GeneratedAt:   record.GeneratedAt,
+		Status:        status,
+	}, nil
+}
+
+// projectRelativePath returns file's slash-separated path inside the project
+func projectRelativePath(projectPath, file string) (string, error) {
+	absProject, err := filepath.Abs(projectPath)
+	if err != nil {
+		return "", err
+	}
+
+	candidate := file
+	if !filepath.IsAbs(candidate) {
+		candidate = filepath.Join(absProject, file)
+		if _, err := os.Stat(candidate); err != nil {
+			if abs, err := filepath.Abs(file); err == nil {
+				if _, err := os.Stat(abs); err == nil {
+					candidate = abs
+				}
+			}
+		}
+	}
+
+	relPath, err := filepath.Rel(absProject, candidate)
+	if err != nil || relPath == ".." || strings.HasPrefix(relPath, ".."+string(filepath.Separator)) {
+		return "", failure.User(fmt.Errorf("%s is outside the project %s", file, projectPath), "")
+	}
+	return filepath.ToSlash(relPath), nil
+}
+
+// summarizeTemplateUsage groups the manifest by template set (the first segment of the
+// template path, e.g. "api" or "crud") and Gophex version
+func summarizeTemplateUsage(projectPath string, files map[string]metadata.FileRecord) ([]templateUsage, error) {
+	groups := make(map[string]*templateUsage)
+
+	for relPath, record := range files {
+		template := "unknown"
+		if record.Template != "" {
+			template = strings.SplitN(record.Template, "/", 2)[0]
+		}
+		key := template + "@" + record.GophexVersion
+
+		usage, ok := groups[key]
+		if !ok {
+			usage = &templateUsage{Template: template, GophexVersion: record.GophexVersion}
+			groups[key] = usage
+		}
+
+		status, err := metadata.FileStatus(projectPath, relPath, record)
+		if err != nil {
+			return nil, err
+		}
+		usage.Files++
+		switch status {
+		case metadata.FileModified:
+			usage.Modified++
+		case metadata.FileMissing:
+			usage.Missing++
+		}
+	}
+
+	usage := make([]templateUsage, 0, len(groups))
+	for _, key := range sortedKeys(groups) {
+		usage = append(usage, *groups[key])
+	}
+	sort.SliceStable(usage, func(i, j int) bool { return usage[i].Files > usage[j].Files })
+	return usage, nil
+}
+
+// writeBlameTable renders a file origin or the template usage summary as text
+func writeBlameTable(out io.Writer, data interface{}) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	switch items := data.(type) {
+	case *fileOrigin:
+		fmt.Fprintf(w, "File:\t%s\n", items.File)
+		fmt.Fprintf(w, "Template:\t%s\n", orDash(items.Template))
+		fmt.Fprintf(w, "Gophex version:\t%s\n", orDash(items.GophexVersion))
+		fmt.Fprintf(w, "Generated:\t%s\n", orDash(items.GeneratedAt))
+		fmt.Fprintf(w, "Status:\t%s\n", blameStatus(items.Status))
+	case []templateUsage:
+		fmt.Fprintln(w, "TEMPLATE\tVERSION\tFILES\tMODIFIED\tMISSING")
+		for _, u := range items {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\n", u.Template, orDash(u.GophexVersion), u.Files, u.Modified, u.Missing)
+		}
+	}
+
+	return w.Flush()
+}
+
+// blameStatus describes a file status for people
+func blameStatus(status string) string {
+	switch status {
+	case metadata.FileModified:
+		return "✏️  modified locally since generation"
+	case metadata.FileMissing:
+		return "❌ deleted since generation"
+	default:
+		return "✅ unmodified"
+	}
+}
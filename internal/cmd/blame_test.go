@@ -0,0 +1,113 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/testutil"
+)
+
+func TestRunBlame(t *testing.T) {
+	projectPath := testutil.WriteProject(t, &metadata.ProjectMetadata{Project: metadata.ProjectInfo{Name: "shop", Type: "api"}}, map[string]string{
+		"cmd/api/main.go":                   "package main\n",
+		"internal/config/config.go":         "package config\n",
+		"internal/domain/product/model.go":  "package product\n",
+		"internal/domain/product/legacy.go": "package product\n",
+	})
+
+	manifest, err := metadata.BuildFileManifest(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to build manifest: %v", err)
+	}
+	for relPath, record := range manifest {
+		record.GophexVersion = "1.4.0"
+		record.Template = "api/" + relPath + ".tmpl"
+		if strings.HasPrefix(relPath, "internal/domain/product/") {
+			record.Template = "crud/product"
+		}
+		manifest[relPath] = record
+	}
+
+	if err := metadata.RecordFiles(projectPath, manifest); err != nil {
+		t.Fatalf("Failed to record manifest: %v", err)
+	}
+
+	// Local changes after generation
+	if err := os.WriteFile(filepath.Join(projectPath, "internal", "config", "config.go"), []byte("package config // tuned\n"), 0644); err != nil {
+		t.Fatalf("Failed to modify config.go: %v", err)
+	}
+	if err := os.Remove(filepath.Join(projectPath, "internal", "domain", "product", "legacy.go")); err != nil {
+		t.Fatalf("Failed to remove legacy.go: %v", err)
+	}
+
+	tests := []struct {
+		file     string
+		template string
+		status   string
+	}{
+		{"cmd/api/main.go", "api/cmd/api/main.go.tmpl", metadata.FileUnmodified},
+		{"internal/config/config.go", "api/internal/config/config.go.tmpl", metadata.FileModified},
+		{"internal/domain/product/legacy.go", "crud/product", metadata.FileMissing},
+	}
+
+	for _, test := range tests {
+		t.Run(test.file, func(t *testing.T) {
+			var out bytes.Buffer
+			if err := runBlame([]string{test.file, "--format", "json", "--path", projectPath}, &out); err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+
+			var origin fileOrigin
+			if err := json.Unmarshal(out.Bytes(), &origin); err != nil {
+				t.Fatalf("Expected valid JSON, got error: %v", err)
+			}
+			if origin.Template != test.template {
+				t.Errorf("Expected template %s, got %s", test.template, origin.Template)
+			}
+			if origin.Status != test.status {
+				t.Errorf("Expected status %s, got %s", test.status, origin.Status)
+			}
+			if origin.GophexVersion != "1.4.0" {
+				t.Errorf("Expected version 1.4.0, got %s", origin.GophexVersion)
+			}
+		})
+	}
+
+	t.Run("not generated", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runBlame([]string{"internal/handwritten.go", "--path", projectPath}, &out)
+		if err == nil || !strings.Contains(err.Error(), "not generated by Gophex") {
+			t.Errorf("Expected error for a file outside the manifest, got %v", err)
+		}
+	})
+
+	t.Run("outside project", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runBlame([]string{"../elsewhere.go", "--path", projectPath}, &out); err == nil {
+			t.Error("Expected error for a file outside the project")
+		}
+	})
+
+	t.Run("usage summary", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runBlame([]string{"--path", projectPath}, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		lines := strings.Split(strings.TrimSpace(out.String()), "\n")
+		if len(lines) != 3 {
+			t.Fatalf("Expected a header and two template sets, got:\n%s", out.String())
+		}
+		if fields := strings.Fields(lines[1]); strings.Join(fields, " ") != "api 1.4.0 2 1 0" {
+			t.Errorf("Expected api usage first, got %q", lines[1])
+		}
+		if fields := strings.Fields(lines[2]); strings.Join(fields, " ") != "crud 1.4.0 2 0 1" {
+			t.Errorf("Expected crud usage second, got %q", lines[2])
+		}
+	})
+}
@@ -2,8 +2,12 @@ package cmd
 
 import (
 	"errors"
+	"path/filepath"
 	"strings"
 	"testing"
+
+	"github.com/buildwithhp/gophex/internal/deps"
+	"github.com/buildwithhp/gophex/internal/generator"
 )
 
 // TestIsUserInterrupt tests the isUserInterrupt function against various error inputs.
@@ -252,25 +256,21 @@ func TestMainFilePathGeneration(t *testing.T) {
 
 	for _, test := range tests {
 		t.Run(test.projectType, func(t *testing.T) {
-			var mainFile string
-
-			// Simulate the logic from project_actions.go
-			switch test.projectType {
-			case "api":
-				mainFile = "cmd/api/main.go"
-			case "webapp":
-				mainFile = "cmd/webapp/main.go"
-			case "microservice":
-				mainFile = "cmd/server/main.go"
-			case "cli":
-				mainFile = "cmd/main.go"
+			mainFile, err := mainFilePath(test.projectType)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
 			}
 
-			if mainFile != test.expected {
-				t.Errorf("Expected %s, got %s", test.expected, mainFile)
+			// Paths use the native separator so they work on Windows too
+			if mainFile != filepath.FromSlash(test.expected) {
+				t.Errorf("Expected %s, got %s", filepath.FromSlash(test.expected), mainFile)
 			}
 		})
 	}
+
+	if _, err := mainFilePath("unknown"); err == nil {
+		t.Error("Expected an error for an unsupported project type")
+	}
 }
 
 func TestProcessNameGeneration(t *testing.T) {
@@ -295,3 +295,95 @@ func TestProcessNameGeneration(t *testing.T) {
 		})
 	}
 }
+
+func TestGoVersionOptions(t *testing.T) {
+	tests := []struct {
+		name            string
+		detected        *generator.GoConfig
+		expectedDefault string
+		expectedFirst   string
+	}{
+		{"no go installed", nil, generator.DefaultGoVersion, generator.SupportedGoVersions[0]},
+		{"supported release installed", &generator.GoConfig{Version: "1.23", Toolchain: "go1.23.4"}, "1.23", generator.SupportedGoVersions[0]},
+		{"newer release installed", &generator.GoConfig{Version: "1.30", Toolchain: "go1.30.1"}, "1.30", "1.30"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			options, defaultOption := goVersionOptions(tt.detected)
+			if got := goVersionName(defaultOption); got != tt.expectedDefault {
+				t.Errorf("Expected default %s, got %s", tt.expectedDefault, got)
+			}
+			if got := goVersionName(options[0]); got != tt.expectedFirst {
+				t.Errorf("Expected first option %s, got %s", tt.expectedFirst, got)
+			}
+		})
+	}
+}
+
+func TestGoConfigForChoice(t *testing.T) {
+	detected := &generator.GoConfig{Version: "1.23", Toolchain: "go1.23.4"}
+
+	if config := goConfigForChoice("1.22", detected); config.Toolchain != "go1.23.4" {
+		t.Errorf("Expected toolchain go1.23.4 for an older go directive, got %q", config.Toolchain)
+	}
+	if config := goConfigForChoice("1.24", detected); config.Toolchain != "" {
+		t.Errorf("Expected no toolchain when the installed Go is older, got %q", config.Toolchain)
+	}
+	if config := goConfigForChoice("1.22", nil); config.Version != "1.22" || config.Toolchain != "" {
+		t.Errorf("Expected go 1.22 without toolchain, got %+v", *config)
+	}
+}
+
+func TestMinimalDepsAvailable(t *testing.T) {
+	tests := []struct {
+		projectType string
+		version     string
+		expected    bool
+	}{
+		{"api", "1.22", true},
+		{"api", "1.21", false},
+		{"microservice", "1.24", true},
+		{"cli", "1.21", true},
+	}
+
+	for _, test := range tests {
+		if available := minimalDepsAvailable(test.projectType, &generator.GoConfig{Version: test.version}); available != test.expected {
+			t.Errorf("Expected minimal deps available=%t for %s on go %s, got %t", test.expected, test.projectType, test.version, available)
+		}
+	}
+}
+
+func TestPlanDependencyUpgrades(t *testing.T) {
+	gin, _ := deps.Lookup("github.com/gin-gonic/gin")
+	requirements := []moduleRequirement{
+		{Path: "github.com/gin-gonic/gin", Version: "v1.9.1"},
+		{Path: "github.com/lib/pq", Version: "v99.0.0"},
+		{Path: "github.com/example/private", Version: "v0.1.0"},
+		{Path: "gopkg.in/yaml.v3", Version: "v3.0.1", Indirect: true},
+	}
+
+	plan := planDependencyUpgrades(requirements)
+	if len(plan) != 3 {
+		t.Fatalf("Expected 3 catalog modules, got %d", len(plan))
+	}
+
+	expected := map[string]bool{
+		"github.com/gin-gonic/gin": true,
+		"github.com/lib/pq":        false,
+		"gopkg.in/yaml.v3":         false,
+	}
+	for _, upgrade := range plan {
+		if upgrade.Upgrade != expected[upgrade.Module] {
+			t.Errorf("Expected upgrade=%t for %s, got %t", expected[upgrade.Module], upgrade.Module, upgrade.Upgrade)
+		}
+		if upgrade.Module == gin.Module && upgrade.Catalog != gin.Version {
+			t.Errorf("Expected catalog version %s, got %s", gin.Version, upgrade.Catalog)
+		}
+	}
+
+	diff := "--- a/go.mod\n+++ b/go.mod\n-\tgithub.com/gin-gonic/gin v1.9.1\n+\tgithub.com/gin-gonic/gin " + gin.Version + "\n"
+	if got := upgradeDiff(plan); got != diff {
+		t.Errorf("Expected only gin in the diff %q, got %q", diff, got)
+	}
+}
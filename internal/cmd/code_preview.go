@@ -0,0 +1,237 @@
+package cmd
+
+import (
+	"fmt"
+	"go/scanner"
+	"go/token"
+	"io"
+	"os"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// ANSI styles used when highlighting code previews
+const (
+	ansiReset   = "\033[0m"
+	ansiDim     = "\033[2m"
+	ansiRed     = "\033[31m"
+	ansiGreen   = "\033[32m"
+	ansiYellow  = "\033[33m"
+	ansiMagenta = "\033[35m"
+	ansiCyan    = "\033[36m"
+	ansiGray    = "\033[90m"
+)
+
+// goPredeclared are the predeclared Go types, constants and functions highlighted in Go code
+var goPredeclared = map[string]bool{
+	"bool": true, "byte": true, "error": true, "float32": true, "float64": true, "int": true,
+	"int32": true, "int64": true, "rune": true, "string": true, "uint": true, "uint64": true, "any": true,
+	"true": true, "false": true, "nil": true, "iota": true,
+	"append": true, "cap": true, "len": true, "make": true, "new": true, "panic": true, "recover": true,
+}
+
+// stdoutIsTerminal reports whether standard output is an interactive terminal
+func stdoutIsTerminal() bool {
+	return term.IsTerminal(int(os.Stdout.Fd()))
+}
+
+// colorEnabled reports whether code previews are colored. NO_COLOR and TERM=dumb turn colors
+// off, and so do accessible mode and output that is not a terminal.
+func colorEnabled() bool {
+	if os.Getenv("NO_COLOR") != "" || os.Getenv("TERM") == "dumb" || accessibleMode {
+		return false
+	}
+	return stdoutIsTerminal()
+}
+
+// renderCodeBlocks highlights the ```-fenced code blocks in text. Go and diff blocks are
+// colored; other blocks, such as directory trees, are left as they are.
+func renderCodeBlocks(text string, color bool) string {
+	if !color {
+		return text
+	}
+
+	var result, block strings.Builder
+	inBlock, language := false, ""
+	for _, line := range strings.SplitAfter(text, "\n") {
+		fence := strings.TrimSpace(line)
+		switch {
+		case !inBlock && strings.HasPrefix(fence, "```"):
+			inBlock, language = true, strings.TrimPrefix(fence, "```")
+			result.WriteString(ansiDim + strings.TrimSuffix(line, "\n") + ansiReset + "\n")
+		case inBlock && fence == "```":
+			result.WriteString(highlightCode(language, block.String()))
+			block.Reset()
+			inBlock = false
+			result.WriteString(ansiDim + strings.TrimSuffix(line, "\n") + ansiReset + "\n")
+		case inBlock:
+			block.WriteString(line)
+		default:
+			result.WriteString(line)
+		}
+	}
+	// An unterminated block is shown as it is
+	result.WriteString(block.String())
+	return result.String()
+}
+
+// highlightCode colors code in the given language, returning other languages unchanged
+func highlightCode(language, code string) string {
+	switch language {
+	case "go":
+		return highlightGo(code)
+	case "diff":
+		return highlightDiff(code)
+	default:
+		return code
+	}
+}
+
+// highlightGo colors keywords, predeclared identifiers, literals and comments in Go code.
+// Snippets need not compile: only tokens are recognised.
+func highlightGo(code string) string {
+	src := []byte(code)
+	fset := token.NewFileSet()
+	file := fset.AddFile("", fset.Base(), len(src))
+
+	var s scanner.Scanner
+	s.Init(file, src, func(token.Position, string) {}, scanner.ScanComments)
+
+	var result strings.Builder
+	last := 0
+	for {
+		pos, tok, lit := s.Scan()
+		if tok == token.EOF {
+			break
+		}
+		// Automatically inserted semicolons are not in the source
+		if tok == token.SEMICOLON && lit == "\n" {
+			continue
+		}
+
+		style := goTokenStyle(tok, lit)
+		if style == "" {
+			continue
+		}
+
+		start := file.Offset(pos)
+		end := start + len(lit)
+		if lit == "" {
+			end = start + len(tok.String())
+		}
+		if start < last || end > len(src) {
+			continue
+		}
+		result.Write(src[last:start])
+		result.WriteString(style)
+		result.Write(src[start:end])
+		result.WriteString(ansiReset)
+		last = end
+	}
+	result.Write(src[last:])
+	return result.String()
+}
+
+// goTokenStyle returns the ANSI style for a Go token, or "" to leave it plain
+func goTokenStyle(tok token.Token, lit string) string {
+	switch {
+	case tok == token.COMMENT:
+		return ansiGray
+	case tok.IsKeyword():
+		return ansiMagenta
+	case tok == token.STRING || tok == token.CHAR:
+		return ansiGreen
+	case tok == token.INT || tok == token.FLOAT || tok == token.IMAG:
+		return ansiYellow
+	case tok == token.IDENT && goPredeclared[lit]:
+		return ansiCyan
+	default:
+		return ""
+	}
+}
+
+// highlightDiff colors added lines green, removed lines red and hunk headers cyan
+func highlightDiff(diff string) string {
+	var result strings.Builder
+	for _, line := range strings.SplitAfter(diff, "\n") {
+		content := strings.TrimSuffix(line, "\n")
+		style := ""
+		switch {
+		case strings.HasPrefix(content, "+++"), strings.HasPrefix(content, "---"):
+			style = ansiDim
+		case strings.HasPrefix(content, "+"):
+			style = ansiGreen
+		case strings.HasPrefix(content, "-"):
+			style = ansiRed
+		case strings.HasPrefix(content, "@@"):
+			style = ansiCyan
+		}
+		if style == "" || content == "" {
+			result.WriteString(line)
+			continue
+		}
+		result.WriteString(style + content + ansiReset + line[len(content):])
+	}
+	return result.String()
+}
+
+// lineDiff returns the lines of file that differ between before and after as a unified diff.
+// It suits edits made in place, such as rewritten import paths, that keep the line count.
+func lineDiff(file string, before, after []byte) string {
+	oldLines := strings.Split(string(before), "\n")
+	newLines := strings.Split(string(after), "\n")
+
+	var diff strings.Builder
+	for i := 0; i < len(oldLines) && i < len(newLines); i++ {
+		if oldLines[i] != newLines[i] {
+			fmt.Fprintf(&diff, "@@ -%d +%d @@\n-%s\n+%s\n", i+1, i+1, oldLines[i], newLines[i])
+		}
+	}
+	if diff.Len() == 0 {
+		return ""
+	}
+	return fmt.Sprintf("--- a/%s\n+++ b/%s\n", file, file) + diff.String()
+}
+
+// writeDiff writes diff as a fenced diff block. On the terminal it is highlighted and
+// paged like the wizards' code previews.
+func writeDiff(out io.Writer, diff string) {
+	block := "```diff\n" + diff + "```\n"
+	if out == io.Writer(os.Stdout) {
+		showPaged(block)
+		return
+	}
+	fmt.Fprint(out, block)
+}
+
+// showPaged prints wizard output with its code blocks highlighted. Output taller than the
+// terminal opens in the documentation pager so the start of long educational content isn't
+// scrolled away.
+func showPaged(text string) {
+	if !needsPager(text) {
+		fmt.Print(renderCodeBlocks(text, colorEnabled()))
+		return
+	}
+
+	pager := newTerminalPager()
+	pager.load("Preview", text)
+	if _, err := pager.run(); err != nil {
+		// A broken terminal must not hide the content
+		fmt.Print(renderCodeBlocks(text, colorEnabled()))
+	}
+}
+
+// needsPager reports whether text is too tall for the terminal. Accessible mode and
+// --defaults never page, since nobody is there to scroll, or a screen reader reads on.
+func needsPager(text string) bool {
+	if accessibleMode || !canPage() {
+		return false
+	}
+	_, height, err := term.GetSize(int(os.Stdout.Fd()))
+	if err != nil || height <= 0 {
+		return false
+	}
+	// Leave room for the prompt that follows
+	return strings.Count(text, "\n") > height-4
+}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+// ansiPattern matches the escape sequences used for highlighting
+var ansiPattern = regexp.MustCompile("\033\\[[0-9;]*m")
+
+func TestHighlightGo(t *testing.T) {
+	code := "// Check cache first\nuser, err := redis.Get(\"user:\" + id)\nif err == nil {\n\treturn user, 5 * time.Minute\n}\n"
+
+	highlighted := highlightGo(code)
+	if stripped := ansiPattern.ReplaceAllString(highlighted, ""); stripped != code {
+		t.Errorf("Expected highlighting to keep the code unchanged, got %q", stripped)
+	}
+
+	for _, expected := range []string{
+		ansiGray + "// Check cache first" + ansiReset,
+		ansiMagenta + "if" + ansiReset,
+		ansiMagenta + "return" + ansiReset,
+		ansiGreen + `"user:"` + ansiReset,
+		ansiCyan + "nil" + ansiReset,
+		ansiYellow + "5" + ansiReset,
+	} {
+		if !strings.Contains(highlighted, expected) {
+			t.Errorf("Expected %q in %q", expected, highlighted)
+		}
+	}
+	if strings.Contains(highlighted, ansiMagenta+"user") {
+		t.Error("Expected identifiers to stay plain")
+	}
+}
+
+func TestRenderCodeBlocks(t *testing.T) {
+	text := "Gin:\n```go\nreturn gin.Default()\n```\n\n📁 Structure:\n```\n├── cmd/\n```\n"
+
+	if rendered := renderCodeBlocks(text, false); rendered != text {
+		t.Errorf("Expected text without colors to be unchanged, got %q", rendered)
+	}
+
+	rendered := renderCodeBlocks(text, true)
+	if stripped := ansiPattern.ReplaceAllString(rendered, ""); stripped != text {
+		t.Errorf("Expected only escape sequences to be added, got %q", stripped)
+	}
+	if !strings.Contains(rendered, ansiMagenta+"return"+ansiReset+" gin.Default()") {
+		t.Errorf("Expected the Go block to be highlighted, got %q", rendered)
+	}
+	if !strings.Contains(rendered, ansiDim+"```go"+ansiReset) {
+		t.Errorf("Expected fences to be dimmed, got %q", rendered)
+	}
+	if !strings.Contains(rendered, "\n├── cmd/\n") {
+		t.Errorf("Expected the directory tree to stay plain, got %q", rendered)
+	}
+}
+
+func TestHighlightDiff(t *testing.T) {
+	diff := "--- a/go.mod\n+++ b/go.mod\n@@ -1 +1 @@\n-module old\n+module new\n"
+
+	highlighted := highlightDiff(diff)
+	for _, expected := range []string{
+		ansiDim + "--- a/go.mod" + ansiReset + "\n",
+		ansiCyan + "@@ -1 +1 @@" + ansiReset + "\n",
+		ansiRed + "-module old" + ansiReset + "\n",
+		ansiGreen + "+module new" + ansiReset + "\n",
+	} {
+		if !strings.Contains(highlighted, expected) {
+			t.Errorf("Expected %q in %q", expected, highlighted)
+		}
+	}
+}
+
+func TestLineDiff(t *testing.T) {
+	before := []byte("package main\n\nimport \"shop/internal/config\"\n")
+	after := []byte("package main\n\nimport \"github.com/acme/shop/internal/config\"\n")
+
+	expected := "--- a/cmd/api/main.go\n+++ b/cmd/api/main.go\n@@ -3 +3 @@\n" +
+		"-import \"shop/internal/config\"\n+import \"github.com/acme/shop/internal/config\"\n"
+	if diff := lineDiff("cmd/api/main.go", before, after); diff != expected {
+		t.Errorf("Expected %q, got %q", expected, diff)
+	}
+	if diff := lineDiff("go.mod", before, before); diff != "" {
+		t.Errorf("Expected no diff for unchanged content, got %q", diff)
+	}
+}
+
+func TestModelPreview(t *testing.T) {
+	entity := &CRUDEntity{
+		Name: "product",
+		Fields: []CRUDField{
+			{Name: "Name", Type: "string", JSONTag: "name", DBTag: "name"},
+			{Name: "PriceCents", Type: "int64", JSONTag: "price_cents", DBTag: "price_cents"},
+		},
+	}
+
+	expected := "// Product represents a product entity\ntype Product struct {\n" +
+		"\tName       string `json:\"name\" db:\"name\"`\n" +
+		"\tPriceCents int64  `json:\"price_cents\" db:\"price_cents\"`\n" +
+		"}\n"
+	if preview := modelPreview(entity); preview != expected {
+		t.Errorf("Expected %q, got %q", expected, preview)
+	}
+}
@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/pkg/version"
+)
+
+// command is a non-interactive gophex subcommand, e.g. "gophex inspect endpoints"
+type command struct {
+	Usage       string
+	Description string
+	Run         func(args []string, out io.Writer) error
+}
+
+// commands lists every non-interactive subcommand by name
+var commands = map[string]command{
+	"inspect": {
+		Usage:       inspectUsage,
+		Description: "Query the project's gophex.md metadata",
+		Run:         runInspect,
+	},
+	"blame": {
+		Usage:       blameUsage,
+		Description: "Show which template and Gophex version produced a file, and whether it was modified",
+		Run:         runBlame,
+	},
+	"dashboard": {
+		Usage:       dashboardUsage,
+		Description: "Show process, migration, drift and scaffold status for all registered projects",
+		Run:         runDashboard,
+	},
+	"export": {
+		Usage:       exportUsage,
+		Description: "Package the project, its gophex.md metadata and an export spec into an archive",
+		Run:         runExport,
+	},
+	"hooks": {
+		Usage:       hooksUsage,
+		Description: "List the project's post_generate, post_crud and pre_start hooks or run them",
+		Run:         runHooks,
+	},
+	"import": {
+		Usage:       importUsage,
+		Description: "Restore an exported project and register it for gophex dashboard",
+		Run:         runImport,
+	},
+	"rename-module": {
+		Usage:       renameModuleUsage,
+		Description: "Change the project's module path and rewrite its imports after a move or rename",
+		Run:         runRenameModule,
+	},
+	"upgrade": {
+		Usage:       upgradeUsage,
+		Description: "Refresh go.mod dependencies to the versions in Gophex's dependency catalog",
+		Run:         runUpgrade,
+	},
+}
+
+// ExecuteCommand runs a non-interactive subcommand; with no arguments it starts interactive mode
+func ExecuteCommand(args []string) error {
+	if len(args) == 0 {
+		return Execute()
+	}
+
+	switch args[0] {
+	case "help", "-h", "--help":
+		printCommandUsage(os.Stdout)
+		return nil
+	case "version", "-v", "--version":
+		fmt.Printf("gophex version %s\n", version.GetVersion())
+		return nil
+	}
+
+	cmd, exists := commands[args[0]]
+	if !exists {
+		printCommandUsage(os.Stderr)
+		return failure.User(fmt.Errorf("unknown command: %s", args[0]), `run "gophex help" to list the commands`)
+	}
+
+	return cmd.Run(args[1:], os.Stdout)
+}
+
+// printCommandUsage prints the list of non-interactive subcommands
+func printCommandUsage(out io.Writer) {
+	fmt.Fprintln(out, "Usage:")
+	fmt.Fprintln(out, "  gophex                 Start interactive mode")
+	fmt.Fprintln(out, "  gophex --accessible    Start interactive mode with numbered, typed prompts for screen readers")
+	fmt.Fprintln(out, "                         (or set GOPHEX_ACCESSIBLE=1)")
+	fmt.Fprintln(out, "  gophex --defaults[=2s] Show each prompt, then answer it with its default after the delay")
+	fmt.Fprintln(out, "  gophex --yes           Answer every prompt with its default immediately")
+
+	names := make([]string, 0, len(commands))
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		fmt.Fprintf(out, "  %s\n", commands[name].Usage)
+		fmt.Fprintf(out, "      %s\n", commands[name].Description)
+	}
+}
+
+// parseCommandFlags parses flags that may appear before, between or after positional arguments
+func parseCommandFlags(fs *flag.FlagSet, args []string) ([]string, error) {
+	var positional []string
+
+	for {
+		if err := fs.Parse(args); err == flag.ErrHelp {
+			return nil, failure.User(err, "")
+		} else if err != nil {
+			return nil, failure.User(err, fmt.Sprintf(`run "gophex %s -h" to list its flags`, fs.Name()))
+		}
+
+		args = fs.Args()
+		if len(args) == 0 {
+			return positional, nil
+		}
+
+		positional = append(positional, args[0])
+		args = args[1:]
+	}
+}
+
+// validateFormat checks an output format flag value
+func validateFormat(format string) error {
+	switch strings.ToLower(format) {
+	case "table", "json":
+		return nil
+	default:
+		return failure.User(fmt.Errorf("unsupported format %q (use table or json)", format), "")
+	}
+}
+
+// usageError reports that a subcommand was called with the wrong arguments
+func usageError(usage string) error {
+	return failure.User(fmt.Errorf("usage: %s", usage), `run "gophex help" to list the commands`)
+}
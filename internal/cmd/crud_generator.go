@@ -8,7 +8,10 @@ import (
 	"text/template"
 	"time"
 
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
 	"github.com/buildwithhp/gophex/internal/utils"
+	"github.com/buildwithhp/gophex/pkg/version"
 )
 
 // CRUDTemplateData contains all data needed for CRUD template generation
@@ -17,6 +20,7 @@ type CRUDTemplateData struct {
 	ModuleName   string
 	ProjectName  string
 	DatabaseType string
+	MinimalDeps  bool // project routes with net/http instead of gorilla/mux
 	Timestamp    string
 }
 
@@ -25,15 +29,15 @@ func generateCRUDCode(projectPath string, entity *CRUDEntity) error {
 	fmt.Printf("🔨 Generating CRUD operations for %s...\n", entity.Name)
 
 	// Load project metadata to get module name and database type
-	metadata, err := utils.LoadMetadata(projectPath)
+	projectMetadata, err := utils.LoadMetadata(projectPath)
 	if err != nil {
 		return fmt.Errorf("failed to load project metadata: %w", err)
 	}
 
-	// Determine module name from go.mod
-	moduleName, err := getModuleName(projectPath)
+	// Determine module name from go.mod, checked against gophex.md and the existing imports
+	moduleName, err := resolveModulePath(projectPath)
 	if err != nil {
-		return fmt.Errorf("failed to get module name: %w", err)
+		return err
 	}
 
 	// Determine database type from existing config
@@ -45,47 +49,114 @@ func generateCRUDCode(projectPath string, entity *CRUDEntity) error {
 	templateData := &CRUDTemplateData{
 		Entity:       entity,
 		ModuleName:   moduleName,
-		ProjectName:  metadata.Project.Name,
+		ProjectName:  projectMetadata.Project.Name,
 		DatabaseType: databaseType,
+		MinimalDeps:  projectMetadata.Features["minimal_dependencies"],
 		Timestamp:    time.Now().Format(time.RFC3339),
 	}
 
+	layers, err := resolveCRUDLayers(projectPath, entity)
+	if err != nil {
+		return err
+	}
+
+	// Snapshot the project so the files this run writes can be attributed to it
+	before, err := metadata.BuildFileManifest(projectPath)
+	if err != nil {
+		return err
+	}
+
 	// Create directory structure
 	if err := createCRUDDirectories(projectPath, entity); err != nil {
 		return fmt.Errorf("failed to create directories: %w", err)
 	}
 
+	if err := generateSupportPackages(projectPath, templateData); err != nil {
+		return fmt.Errorf("failed to generate clock and idgen packages: %w", err)
+	}
+
 	// Generate files
-	if err := generateModelFile(projectPath, templateData); err != nil {
-		return fmt.Errorf("failed to generate model: %w", err)
+	if layers.generate.HasLayer(metadata.LayerDomain) {
+		if err := generateModelFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate model: %w", err)
+		}
+
+		if err := generateRepositoryFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate repository interface: %w", err)
+		}
+
+		if err := generateServiceFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate service: %w", err)
+		}
 	}
 
-	if err := generateRepositoryFile(projectPath, templateData); err != nil {
-		return fmt.Errorf("failed to generate repository: %w", err)
+	if layers.generate.HasLayer(metadata.LayerRepository) {
+		if err := generateDatabaseRepositoryFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate repository: %w", err)
+		}
+
+		if err := generateMemoryRepositoryFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate in-memory repository: %w", err)
+		}
 	}
 
-	if err := generateServiceFile(projectPath, templateData); err != nil {
-		return fmt.Errorf("failed to generate service: %w", err)
+	if layers.generate.HasLayer(metadata.LayerHTTP) {
+		if err := generateHandlerFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate handler: %w", err)
+		}
+
+		if err := generateEntityRoutesFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate entity routes: %w", err)
+		}
+
+		if err := updateRoutesFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to update routes: %w", err)
+		}
 	}
 
-	if err := generateHandlerFile(projectPath, templateData); err != nil {
-		return fmt.Errorf("failed to generate handler: %w", err)
+	// The integration tests need the in-memory repository and the routes, which may come from different runs
+	generatesTestDeps := layers.generate.HasLayer(metadata.LayerRepository) || layers.generate.HasLayer(metadata.LayerHTTP)
+	if generatesTestDeps && layers.complete() {
+		if err := generateIntegrationTestFile(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate integration tests: %w", err)
+		}
 	}
 
-	if err := updateRoutesFile(projectPath, templateData); err != nil {
-		return fmt.Errorf("failed to update routes: %w", err)
+	if err := recordCRUDMetadata(projectPath, templateData); err != nil {
+		fmt.Printf("⚠️  Warning: Could not record entity in gophex.md: %v\n", err)
 	}
 
-	if err := generateMigrationFiles(projectPath, templateData); err != nil {
-		return fmt.Errorf("failed to generate migrations: %w", err)
+	if layers.generate.HasLayer(metadata.LayerRepository) {
+		if err := generateMigrationFiles(projectPath, templateData); err != nil {
+			return fmt.Errorf("failed to generate migrations: %w", err)
+		}
 	}
 
 	if err := generateDocumentation(projectPath, templateData); err != nil {
 		return fmt.Errorf("failed to generate documentation: %w", err)
 	}
 
+	if err := recordCRUDFiles(projectPath, templateData, before); err != nil {
+		fmt.Printf("⚠️  Warning: Could not record generated files in gophex.md: %v\n", err)
+	}
+
 	fmt.Printf("✅ Successfully generated CRUD operations for %s!\n\n", entity.Name)
 
+	generatedLayers := entity.Layers
+	if len(generatedLayers) == 0 {
+		generatedLayers = metadata.AllLayers
+	}
+	hookEnv := map[string]string{"GOPHEX_ENTITY": entity.Name, "GOPHEX_LAYERS": strings.Join(generatedLayers, ",")}
+	if err := runProjectHooks(projectPath, metadata.HookPostCRUD, hookEnv, os.Stdout); err != nil {
+		fmt.Printf("⚠️  Warning: %v\n\n", err)
+	}
+
+	if missing := layers.present.MissingLayers(); len(missing) > 0 {
+		fmt.Printf("🧩 Layers not generated yet: %s\n", strings.Join(missing, ", "))
+		fmt.Printf("   They are recorded in gophex.md; rerun the enhanced CRUD wizard for %s to fill them in.\n\n", entity.Name)
+		return nil
+	}
+
 	// Show next steps
 	showNextSteps(entity)
 
@@ -94,10 +165,15 @@ func generateCRUDCode(projectPath string, entity *CRUDEntity) error {
 
 // createCRUDDirectories creates necessary directory structure
 func createCRUDDirectories(projectPath string, entity *CRUDEntity) error {
-	dirs := []string{
-		filepath.Join(projectPath, "internal", "domain", entity.Name),
-		filepath.Join(projectPath, "internal", "api", "handlers"),
-		filepath.Join(projectPath, "migrations"),
+	dirs := []string{filepath.Join(projectPath, "internal", "domain", entity.Name)}
+	if entity.HasLayer(metadata.LayerRepository) {
+		dirs = append(dirs, filepath.Join(projectPath, "migrations"))
+	}
+	if entity.HasLayer(metadata.LayerHTTP) {
+		dirs = append(dirs,
+			filepath.Join(projectPath, "internal", "api", "handlers"),
+			filepath.Join(projectPath, "internal", "api", "routes"),
+		)
 	}
 
 	for _, dir := range dirs {
@@ -114,9 +190,17 @@ func generateModelFile(projectPath string, data *CRUDTemplateData) error {
 	tmpl := `package {{.Entity.Name}}
 
 import (
-	"time"
-{{if hasTimeFields .Entity.Fields}}	"database/sql/driver"
-	"fmt"{{end}}
+	"errors"
+{{if hasRequiredChecks .Entity.Fields}}	"fmt"
+{{end}}{{if hasTimeFields .Entity.Fields}}	"time"
+{{end}}{{if eq .DatabaseType "mongodb"}}
+	"go.mongodb.org/mongo-driver/bson/primitive"
+{{end}})
+
+// Errors returned by the {{.Entity.Name}} repository and service; handlers map them to HTTP status codes
+var (
+	ErrNotFound = errors.New("{{.Entity.Name}} not found")
+	ErrInvalid  = errors.New("invalid {{.Entity.Name}}")
 )
 
 // {{title .Entity.Name}} represents a {{.Entity.Name}} entity
@@ -176,10 +260,10 @@ func ({{lower .Entity.Name}} *{{title .Entity.Name}}) ToResponse() {{title .Enti
 
 // Validate validates the {{title .Entity.Name}} fields
 func ({{lower .Entity.Name}} *{{title .Entity.Name}}) Validate() error {
-{{range .Entity.Fields}}{{if .Required}}	if {{if eq .Type "string"}}{{lower $.Entity.Name}}.{{.Name}} == ""{{else if eq .Type "int"}}{{lower $.Entity.Name}}.{{.Name}} == 0{{else if eq .Type "int64"}}{{lower $.Entity.Name}}.{{.Name}} == 0{{else}}{{lower $.Entity.Name}}.{{.Name}} == nil{{end}} {
-		return fmt.Errorf("{{.Name}} is required")
+{{range .Entity.Fields}}{{if .Required}}{{$name := .Name}}{{with isZero (printf "%s.%s" (lower $.Entity.Name) .Name) .Type}}	if {{.}} {
+		return fmt.Errorf("%w: {{$name}} is required", ErrInvalid)
 	}
-{{end}}{{end}}
+{{end}}{{end}}{{end}}
 	return nil
 }
 `
@@ -188,19 +272,11 @@ func ({{lower .Entity.Name}} *{{title .Entity.Name}}) Validate() error {
 	return executeTemplate(tmpl, filePath, data)
 }
 
-// generateRepositoryFile generates the repository file
+// generateRepositoryFile generates the Repository interface, the domain layer's storage contract
 func generateRepositoryFile(projectPath string, data *CRUDTemplateData) error {
 	tmpl := `package {{.Entity.Name}}
 
-import (
-	"context"
-	"fmt"
-{{if eq .DatabaseType "mongodb"}}	"go.mongodb.org/mongo-driver/bson"
-	"go.mongodb.org/mongo-driver/bson/primitive"
-	"go.mongodb.org/mongo-driver/mongo"
-	"go.mongodb.org/mongo-driver/mongo/options"{{else}}	"database/sql"
-	"strings"{{end}}
-)
+import "context"
 
 // Repository defines the interface for {{.Entity.Name}} data operations
 type Repository interface {
@@ -211,22 +287,53 @@ type Repository interface {
 {{if or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}	Patch(ctx context.Context, id {{if eq .DatabaseType "mongodb"}}string{{else}}int64{{end}}, updates map[string]interface{}) error{{end}}
 	Delete(ctx context.Context, id {{if eq .DatabaseType "mongodb"}}string{{else}}int64{{end}}) error
 }
+`
 
+	filePath := filepath.Join(projectPath, "internal", "domain", data.Entity.Name, "repository.go")
+	return executeTemplate(tmpl, filePath, data)
+}
+
+// databaseRepositoryFile returns the name of the database-backed repository file
+func databaseRepositoryFile(databaseType string) string {
+	if databaseType == "mongodb" {
+		return "mongo_repository.go"
+	}
+	return "sql_repository.go"
+}
+
+// generateDatabaseRepositoryFile generates the Repository implementation for the project's database
+func generateDatabaseRepositoryFile(projectPath string, data *CRUDTemplateData) error {
+	tmpl := `package {{.Entity.Name}}
+
+import (
+	"context"
+	"fmt"
+{{if eq .DatabaseType "mongodb"}}	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	"{{.ModuleName}}/internal/pkg/idgen"{{else}}	"database/sql"
+{{if or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}	"strings"
+{{end}}{{end}}
+)
 {{if eq .DatabaseType "mongodb"}}
 // mongoRepository implements Repository for MongoDB
 type mongoRepository struct {
 	collection *mongo.Collection
+	ids        idgen.Generator
 }
 
-// NewRepository creates a new MongoDB repository
-func NewRepository(db *mongo.Database) Repository {
+// NewRepository creates a new MongoDB repository; ids assigns the ObjectIDs of new documents
+func NewRepository(db *mongo.Database, ids idgen.Generator) Repository {
 	return &mongoRepository{
 		collection: db.Collection("{{.Entity.PluralName}}"),
+		ids:        ids,
 	}
 }
 
 func (r *mongoRepository) Create(ctx context.Context, {{.Entity.Name}} *{{title .Entity.Name}}) error {
-	{{.Entity.Name}}.ID = primitive.NewObjectID()
+	{{.Entity.Name}}.ID = r.ids.NewID()
 	result, err := r.collection.InsertOne(ctx, {{.Entity.Name}})
 	if err != nil {
 		return fmt.Errorf("failed to create {{.Entity.Name}}: %w", err)
@@ -238,14 +345,14 @@ func (r *mongoRepository) Create(ctx context.Context, {{.Entity.Name}} *{{title
 func (r *mongoRepository) GetByID(ctx context.Context, id string) (*{{title .Entity.Name}}, error) {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return nil, fmt.Errorf("invalid ID format: %w", err)
+		return nil, fmt.Errorf("%w: invalid ID format: %w", ErrInvalid, err)
 	}
 
 	var {{.Entity.Name}} {{title .Entity.Name}}
 	err = r.collection.FindOne(ctx, bson.M{"_id": objectID}).Decode(&{{.Entity.Name}})
 	if err != nil {
 		if err == mongo.ErrNoDocuments {
-			return nil, fmt.Errorf("{{.Entity.Name}} not found")
+			return nil, ErrNotFound
 		}
 		return nil, fmt.Errorf("failed to get {{.Entity.Name}}: %w", err)
 	}
@@ -284,7 +391,7 @@ func (r *mongoRepository) Update(ctx context.Context, {{.Entity.Name}} *{{title
 		return fmt.Errorf("failed to update {{.Entity.Name}}: %w", err)
 	}
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("{{.Entity.Name}} not found")
+		return ErrNotFound
 	}
 	return nil
 }
@@ -294,7 +401,7 @@ func (r *mongoRepository) Update(ctx context.Context, {{.Entity.Name}} *{{title
 func (r *mongoRepository) Patch(ctx context.Context, id string, updates map[string]interface{}) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid ID format: %w", err)
+		return fmt.Errorf("%w: invalid ID format: %w", ErrInvalid, err)
 	}
 
 	filter := bson.M{"_id": objectID}
@@ -305,7 +412,7 @@ func (r *mongoRepository) Patch(ctx context.Context, id string, updates map[stri
 		return fmt.Errorf("failed to patch {{.Entity.Name}}: %w", err)
 	}
 	if result.MatchedCount == 0 {
-		return fmt.Errorf("{{.Entity.Name}} not found")
+		return ErrNotFound
 	}
 	return nil
 }
@@ -314,7 +421,7 @@ func (r *mongoRepository) Patch(ctx context.Context, id string, updates map[stri
 func (r *mongoRepository) Delete(ctx context.Context, id string) error {
 	objectID, err := primitive.ObjectIDFromHex(id)
 	if err != nil {
-		return fmt.Errorf("invalid ID format: %w", err)
+		return fmt.Errorf("%w: invalid ID format: %w", ErrInvalid, err)
 	}
 
 	result, err := r.collection.DeleteOne(ctx, bson.M{"_id": objectID})
@@ -322,7 +429,7 @@ func (r *mongoRepository) Delete(ctx context.Context, id string) error {
 		return fmt.Errorf("failed to delete {{.Entity.Name}}: %w", err)
 	}
 	if result.DeletedCount == 0 {
-		return fmt.Errorf("{{.Entity.Name}} not found")
+		return ErrNotFound
 	}
 	return nil
 }
@@ -355,7 +462,7 @@ func (r *sqlRepository) GetByID(ctx context.Context, id int64) (*{{title .Entity
 	err := r.db.QueryRowContext(ctx, query, id).Scan(&{{.Entity.Name}}.ID{{range .Entity.Fields}}, &{{$.Entity.Name}}.{{.Name}}{{end}})
 	if err != nil {
 		if err == sql.ErrNoRows {
-			return nil, fmt.Errorf("{{.Entity.Name}} not found")
+			return nil, ErrNotFound
 		}
 		return nil, fmt.Errorf("failed to get {{.Entity.Name}}: %w", err)
 	}
@@ -407,7 +514,7 @@ func (r *sqlRepository) Update(ctx context.Context, {{.Entity.Name}} *{{title .E
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("{{.Entity.Name}} not found")
+		return ErrNotFound
 	}
 	return nil
 }
@@ -442,7 +549,7 @@ func (r *sqlRepository) Patch(ctx context.Context, id int64, updates map[string]
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("{{.Entity.Name}} not found")
+		return ErrNotFound
 	}
 	return nil
 }
@@ -461,14 +568,14 @@ func (r *sqlRepository) Delete(ctx context.Context, id int64) error {
 		return fmt.Errorf("failed to get rows affected: %w", err)
 	}
 	if rowsAffected == 0 {
-		return fmt.Errorf("{{.Entity.Name}} not found")
+		return ErrNotFound
 	}
 	return nil
 }
 {{end}}
 `
 
-	filePath := filepath.Join(projectPath, "internal", "domain", data.Entity.Name, "repository.go")
+	filePath := filepath.Join(projectPath, "internal", "domain", data.Entity.Name, databaseRepositoryFile(data.DatabaseType))
 	return executeTemplate(tmpl, filePath, data)
 }
 
@@ -496,11 +603,25 @@ func executeTemplate(tmplStr, filePath string, data interface{}) error {
 			}
 			return false
 		},
+		"isZero": zeroCheck,
+		"hasRequiredChecks": func(fields []CRUDField) bool {
+			for _, field := range fields {
+				if field.Required && zeroCheck("x", field.Type) != "" {
+					return true
+				}
+			}
+			return false
+		},
+		"sampleValue":        sampleValue,
+		"requestFields":      requestFields,
+		"firstStringField":   firstStringField,
+		"firstRequiredField": firstRequiredField,
+		"firstField":         firstField,
 	}
 
 	tmpl, err := template.New("crud").Funcs(funcMap).Parse(tmplStr)
 	if err != nil {
-		return fmt.Errorf("failed to parse template: %w", err)
+		return failure.Template(fmt.Errorf("failed to parse template: %w", err), failure.TemplateBugHint)
 	}
 
 	file, err := os.Create(filePath)
@@ -510,30 +631,13 @@ func executeTemplate(tmplStr, filePath string, data interface{}) error {
 	defer file.Close()
 
 	if err := tmpl.Execute(file, data); err != nil {
-		return fmt.Errorf("failed to execute template: %w", err)
+		return failure.Template(fmt.Errorf("failed to execute template: %w", err), failure.TemplateBugHint)
 	}
 
 	return nil
 }
 
 // Helper functions to get project information
-func getModuleName(projectPath string) (string, error) {
-	goModPath := filepath.Join(projectPath, "go.mod")
-	content, err := os.ReadFile(goModPath)
-	if err != nil {
-		return "", fmt.Errorf("failed to read go.mod: %w", err)
-	}
-
-	lines := strings.Split(string(content), "\n")
-	for _, line := range lines {
-		if strings.HasPrefix(line, "module ") {
-			return strings.TrimSpace(strings.TrimPrefix(line, "module ")), nil
-		}
-	}
-
-	return "", fmt.Errorf("module name not found in go.mod")
-}
-
 func getDatabaseType(projectPath string) (string, error) {
 	// Check if MongoDB files exist
 	mongoPath := filepath.Join(projectPath, "internal", "infrastructure", "database", "mongodb")
@@ -552,7 +656,8 @@ func generateServiceFile(projectPath string, data *CRUDTemplateData) error {
 import (
 	"context"
 	"fmt"
-	"time"
+
+	"{{.ModuleName}}/internal/pkg/clock"
 )
 
 // Service defines the business logic interface for {{.Entity.Name}}
@@ -567,12 +672,13 @@ type Service interface {
 
 // service implements Service interface
 type service struct {
-	repo Repository
+	repo  Repository
+	clock clock.Clock // stamps CreatedAt/UpdatedAt; tests inject a clock.Fake
 }
 
 // NewService creates a new {{.Entity.Name}} service
-func NewService(repo Repository) Service {
-	return &service{repo: repo}
+func NewService(repo Repository, clock clock.Clock) Service {
+	return &service{repo: repo, clock: clock}
 }
 
 // Create creates a new {{.Entity.Name}}
@@ -585,8 +691,8 @@ func (s *service) Create(ctx context.Context, req Create{{title .Entity.Name}}Re
 	// Create entity
 	{{.Entity.Name}} := &{{title .Entity.Name}}{
 {{range .Entity.Fields}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}		{{.Name}}: req.{{.Name}},
-{{end}}{{end}}{{end}}{{if hasField .Entity.Fields "CreatedAt"}}		CreatedAt: time.Now(),{{end}}
-{{if hasField .Entity.Fields "UpdatedAt"}}		UpdatedAt: time.Now(),{{end}}
+{{end}}{{end}}{{end}}{{if hasField .Entity.Fields "CreatedAt"}}		CreatedAt: s.clock.Now(),{{end}}
+{{if hasField .Entity.Fields "UpdatedAt"}}		UpdatedAt: s.clock.Now(),{{end}}
 	}
 
 	if err := s.repo.Create(ctx, {{.Entity.Name}}); err != nil {
@@ -656,7 +762,7 @@ func (s *service) Update(ctx context.Context, id {{if eq .DatabaseType "mongodb"
 	updated := &{{title .Entity.Name}}{
 		ID: existing.ID,
 {{range .Entity.Fields}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}		{{.Name}}: req.{{.Name}},
-{{else}}		{{.Name}}: time.Now(),{{end}}{{else}}		{{.Name}}: existing.{{.Name}},{{end}}{{end}}
+{{else}}		{{.Name}}: s.clock.Now(),{{end}}{{else}}		{{.Name}}: existing.{{.Name}},{{end}}{{end}}
 	}
 
 	if err := s.repo.Update(ctx, updated); err != nil {
@@ -685,7 +791,7 @@ func (s *service) Patch(ctx context.Context, id {{if eq .DatabaseType "mongodb"}
 	}
 {{end}}{{end}}{{end}}
 {{if hasField .Entity.Fields "UpdatedAt"}}	// Always update the UpdatedAt timestamp for PATCH operations
-	updates["updated_at"] = time.Now()
+	updates["updated_at"] = s.clock.Now()
 {{end}}
 
 	if len(updates) == 0 {
@@ -725,20 +831,20 @@ func (s *service) Delete(ctx context.Context, id {{if eq .DatabaseType "mongodb"
 // Validation methods
 
 func (s *service) validateCreateRequest(req Create{{title .Entity.Name}}Request) error {
-{{range .Entity.Fields}}{{if .Required}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}	if {{if eq .Type "string"}}req.{{.Name}} == ""{{else if eq .Type "int"}}req.{{.Name}} == 0{{else if eq .Type "int64"}}req.{{.Name}} == 0{{else}}req.{{.Name}} == nil{{end}} {
-		return fmt.Errorf("{{.Name}} is required")
+{{range .Entity.Fields}}{{if .Required}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}{{$name := .Name}}{{with isZero (printf "req.%s" .Name) .Type}}	if {{.}} {
+		return fmt.Errorf("%w: {{$name}} is required", ErrInvalid)
 	}
-{{end}}{{end}}{{end}}{{end}}
+{{end}}{{end}}{{end}}{{end}}{{end}}
 	return nil
 }
 
 {{if or (eq .Entity.UpdateMethod "put") (eq .Entity.UpdateMethod "both")}}
 func (s *service) validateUpdateRequest(req Update{{title .Entity.Name}}Request) error {
 	// For PUT requests, all required fields must be provided (complete replacement)
-{{range .Entity.Fields}}{{if .Required}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}	if {{if eq .Type "string"}}req.{{.Name}} == ""{{else if eq .Type "int"}}req.{{.Name}} == 0{{else if eq .Type "int64"}}req.{{.Name}} == 0{{else}}req.{{.Name}} == nil{{end}} {
-		return fmt.Errorf("{{.Name}} is required for complete update")
+{{range .Entity.Fields}}{{if .Required}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}{{$name := .Name}}{{with isZero (printf "req.%s" .Name) .Type}}	if {{.}} {
+		return fmt.Errorf("%w: {{$name}} is required for complete update", ErrInvalid)
 	}
-{{end}}{{end}}{{end}}{{end}}
+{{end}}{{end}}{{end}}{{end}}{{end}}
 	return nil
 }
 {{end}}
@@ -746,10 +852,10 @@ func (s *service) validateUpdateRequest(req Update{{title .Entity.Name}}Request)
 {{if or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}
 func (s *service) validatePatchRequest(req Patch{{title .Entity.Name}}Request) error {
 	// For PATCH requests, only validate the fields that are being updated
-{{range .Entity.Fields}}{{if .Required}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}	if req.{{.Name}} != nil && {{if eq .Type "string"}}*req.{{.Name}} == ""{{else if eq .Type "int"}}*req.{{.Name}} == 0{{else if eq .Type "int64"}}*req.{{.Name}} == 0{{else}}*req.{{.Name}} == nil{{end}} {
-		return fmt.Errorf("{{.Name}} cannot be empty when provided")
+{{range .Entity.Fields}}{{if .Required}}{{if not (eq .Name "CreatedAt")}}{{if not (eq .Name "UpdatedAt")}}{{$name := .Name}}{{with isZero (printf "*req.%s" .Name) .Type}}	if req.{{$name}} != nil && {{.}} {
+		return fmt.Errorf("%w: {{$name}} cannot be empty when provided", ErrInvalid)
 	}
-{{end}}{{end}}{{end}}{{end}}
+{{end}}{{end}}{{end}}{{end}}{{end}}
 	return nil
 }
 {{end}}
@@ -765,11 +871,12 @@ func generateHandlerFile(projectPath string, data *CRUDTemplateData) error {
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
-	"github.com/gorilla/mux"
-	"{{.ModuleName}}/internal/domain/{{.Entity.Name}}"
+{{if not .MinimalDeps}}	"github.com/gorilla/mux"
+{{end}}	"{{.ModuleName}}/internal/domain/{{.Entity.Name}}"
 	"{{.ModuleName}}/internal/api/responses"
 )
 
@@ -793,7 +900,7 @@ func (h *{{title .Entity.Name}}Handler) Create{{title .Entity.Name}}(w http.Resp
 
 	{{.Entity.Name}}Response, err := h.service.Create(r.Context(), req)
 	if err != nil {
-		responses.Error(w, http.StatusInternalServerError, "Failed to create {{.Entity.Name}}", err)
+		responses.Error(w, {{.Entity.Name}}ErrorStatus(err), "Failed to create {{.Entity.Name}}", err)
 		return
 	}
 
@@ -802,8 +909,8 @@ func (h *{{title .Entity.Name}}Handler) Create{{title .Entity.Name}}(w http.Resp
 
 // Get{{title .Entity.Name}} handles GET /api/{{.Entity.PluralName}}/{id}
 func (h *{{title .Entity.Name}}Handler) Get{{title .Entity.Name}}(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+{{if .MinimalDeps}}	idStr := r.PathValue("id"){{else}}	vars := mux.Vars(r)
+	idStr := vars["id"]{{end}}
 
 {{if eq .DatabaseType "mongodb"}}	{{.Entity.Name}}Response, err := h.service.GetByID(r.Context(), idStr){{else}}	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
@@ -813,7 +920,7 @@ func (h *{{title .Entity.Name}}Handler) Get{{title .Entity.Name}}(w http.Respons
 
 	{{.Entity.Name}}Response, err := h.service.GetByID(r.Context(), id){{end}}
 	if err != nil {
-		responses.Error(w, http.StatusNotFound, "{{title .Entity.Name}} not found", err)
+		responses.Error(w, {{.Entity.Name}}ErrorStatus(err), "{{title .Entity.Name}} not found", err)
 		return
 	}
 
@@ -851,8 +958,8 @@ func (h *{{title .Entity.Name}}Handler) List{{title .Entity.PluralName}}(w http.
 // Update{{title .Entity.Name}} handles PUT /api/{{.Entity.PluralName}}/{id}
 // PUT performs a complete replacement of the resource - all fields must be provided
 func (h *{{title .Entity.Name}}Handler) Update{{title .Entity.Name}}(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+{{if .MinimalDeps}}	idStr := r.PathValue("id"){{else}}	vars := mux.Vars(r)
+	idStr := vars["id"]{{end}}
 
 {{if eq .DatabaseType "mongodb"}}	var req {{.Entity.Name}}.Update{{title .Entity.Name}}Request
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -874,7 +981,7 @@ func (h *{{title .Entity.Name}}Handler) Update{{title .Entity.Name}}(w http.Resp
 
 	{{.Entity.Name}}Response, err := h.service.Update(r.Context(), id, req){{end}}
 	if err != nil {
-		responses.Error(w, http.StatusInternalServerError, "Failed to update {{.Entity.Name}}", err)
+		responses.Error(w, {{.Entity.Name}}ErrorStatus(err), "Failed to update {{.Entity.Name}}", err)
 		return
 	}
 
@@ -886,8 +993,8 @@ func (h *{{title .Entity.Name}}Handler) Update{{title .Entity.Name}}(w http.Resp
 // Patch{{title .Entity.Name}} handles PATCH /api/{{.Entity.PluralName}}/{id}
 // PATCH performs a partial update - only provided fields will be updated
 func (h *{{title .Entity.Name}}Handler) Patch{{title .Entity.Name}}(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+{{if .MinimalDeps}}	idStr := r.PathValue("id"){{else}}	vars := mux.Vars(r)
+	idStr := vars["id"]{{end}}
 
 {{if eq .DatabaseType "mongodb"}}	var req {{.Entity.Name}}.Patch{{title .Entity.Name}}Request
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
@@ -909,7 +1016,7 @@ func (h *{{title .Entity.Name}}Handler) Patch{{title .Entity.Name}}(w http.Respo
 
 	{{.Entity.Name}}Response, err := h.service.Patch(r.Context(), id, req){{end}}
 	if err != nil {
-		responses.Error(w, http.StatusInternalServerError, "Failed to patch {{.Entity.Name}}", err)
+		responses.Error(w, {{.Entity.Name}}ErrorStatus(err), "Failed to patch {{.Entity.Name}}", err)
 		return
 	}
 
@@ -919,8 +1026,8 @@ func (h *{{title .Entity.Name}}Handler) Patch{{title .Entity.Name}}(w http.Respo
 
 // Delete{{title .Entity.Name}} handles DELETE /api/{{.Entity.PluralName}}/{id}
 func (h *{{title .Entity.Name}}Handler) Delete{{title .Entity.Name}}(w http.ResponseWriter, r *http.Request) {
-	vars := mux.Vars(r)
-	idStr := vars["id"]
+{{if .MinimalDeps}}	idStr := r.PathValue("id"){{else}}	vars := mux.Vars(r)
+	idStr := vars["id"]{{end}}
 
 {{if eq .DatabaseType "mongodb"}}	err := h.service.Delete(r.Context(), idStr){{else}}	id, err := strconv.ParseInt(idStr, 10, 64)
 	if err != nil {
@@ -930,12 +1037,24 @@ func (h *{{title .Entity.Name}}Handler) Delete{{title .Entity.Name}}(w http.Resp
 
 	err = h.service.Delete(r.Context(), id){{end}}
 	if err != nil {
-		responses.Error(w, http.StatusInternalServerError, "Failed to delete {{.Entity.Name}}", err)
+		responses.Error(w, {{.Entity.Name}}ErrorStatus(err), "Failed to delete {{.Entity.Name}}", err)
 		return
 	}
 
 	responses.Success(w, http.StatusOK, "{{title .Entity.Name}} deleted successfully", nil)
 }
+
+// {{.Entity.Name}}ErrorStatus maps {{.Entity.Name}} domain errors to HTTP status codes
+func {{.Entity.Name}}ErrorStatus(err error) int {
+	switch {
+	case errors.Is(err, {{.Entity.Name}}.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, {{.Entity.Name}}.ErrInvalid):
+		return http.StatusBadRequest
+	default:
+		return http.StatusInternalServerError
+	}
+}
 `
 
 	filePath := filepath.Join(projectPath, "internal", "api", "handlers", data.Entity.Name+".go")
@@ -951,61 +1070,117 @@ func updateRoutesFile(projectPath string, data *CRUDTemplateData) error {
 		return createRoutesFile(projectPath, data)
 	}
 
-	// For now, just create a comment about manual route addition
+	// For now, print the registration to add to the existing Setup function
 	// In a full implementation, this would parse and modify the existing routes.go file
-	fmt.Printf("📝 Please add the following routes to your routes.go file:\n")
-	fmt.Printf("   router.HandleFunc(\"/api/%s\", %sHandler.Create%s).Methods(\"POST\")\n",
-		data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
-	fmt.Printf("   router.HandleFunc(\"/api/%s\", %sHandler.List%s).Methods(\"GET\")\n",
-		data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.PluralName))
-	fmt.Printf("   router.HandleFunc(\"/api/%s/{id}\", %sHandler.Get%s).Methods(\"GET\")\n",
-		data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
-
-	switch data.Entity.UpdateMethod {
-	case "put":
-		fmt.Printf("   router.HandleFunc(\"/api/%s/{id}\", %sHandler.Update%s).Methods(\"PUT\")\n",
-			data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
-	case "patch":
-		fmt.Printf("   router.HandleFunc(\"/api/%s/{id}\", %sHandler.Patch%s).Methods(\"PATCH\")\n",
-			data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
-	case "both":
-		fmt.Printf("   router.HandleFunc(\"/api/%s/{id}\", %sHandler.Update%s).Methods(\"PUT\")\n",
-			data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
-		fmt.Printf("   router.HandleFunc(\"/api/%s/{id}\", %sHandler.Patch%s).Methods(\"PATCH\")\n",
-			data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
+	repository := "NewRepository(db.GetDB())"
+	if data.DatabaseType == "mongodb" {
+		repository = "NewRepository(db.GetDatabase(), idgen.ObjectIDs())"
 	}
 
-	fmt.Printf("   router.HandleFunc(\"/api/%s/{id}\", %sHandler.Delete%s).Methods(\"DELETE\")\n",
-		data.Entity.PluralName, data.Entity.Name, strings.Title(data.Entity.Name))
-	fmt.Println()
+	fmt.Printf("📝 Please register the %s routes in internal/api/routes/routes.go:\n", data.Entity.Name)
+	fmt.Printf("   %sService := %s.NewService(%s.%s, clock.System())\n", data.Entity.Name, data.Entity.Name, data.Entity.Name, repository)
+	fmt.Printf("   Register%sRoutes(router, %sService)\n\n", strings.Title(data.Entity.Name), data.Entity.Name)
 
 	return nil
 }
 
+// crudEndpoints describes the endpoints exposed by the generated CRUD routes
+func crudEndpoints(data *CRUDTemplateData) []metadata.EndpointInfo {
+	entity := data.Entity
+	title := strings.Title(entity.Name)
+	collection := fmt.Sprintf("/api/%s", entity.PluralName)
+	item := collection + "/{id}"
+
+	endpoints := []metadata.EndpointInfo{
+		{Method: "POST", Path: collection, Description: "Create " + entity.Name, RequestSchema: "Create" + title + "Request", ResponseSchema: title + "Response"},
+		{Method: "GET", Path: collection, Description: "List " + entity.PluralName, ResponseSchema: "List" + strings.Title(entity.PluralName) + "Response"},
+		{Method: "GET", Path: item, Description: "Get " + entity.Name + " by ID", ResponseSchema: title + "Response"},
+	}
+
+	if entity.UpdateMethod == "put" || entity.UpdateMethod == "both" {
+		endpoints = append(endpoints, metadata.EndpointInfo{Method: "PUT", Path: item, Description: "Replace " + entity.Name, RequestSchema: "Update" + title + "Request", ResponseSchema: title + "Response"})
+	}
+	if entity.UpdateMethod == "patch" || entity.UpdateMethod == "both" {
+		endpoints = append(endpoints, metadata.EndpointInfo{Method: "PATCH", Path: item, Description: "Partially update " + entity.Name, RequestSchema: "Patch" + title + "Request", ResponseSchema: title + "Response"})
+	}
+
+	endpoints = append(endpoints, metadata.EndpointInfo{Method: "DELETE", Path: item, Description: "Delete " + entity.Name})
+
+	for i := range endpoints {
+		endpoints[i].Auth = metadata.AuthSchemeNone
+		endpoints[i].Tags = []string{entity.PluralName}
+	}
+
+	return endpoints
+}
+
+// recordCRUDMetadata adds the generated entity and its endpoints to the project metadata
+func recordCRUDMetadata(projectPath string, data *CRUDTemplateData) error {
+	entity := metadata.EntityInfo{
+		Name:         data.Entity.Name,
+		PluralName:   data.Entity.PluralName,
+		UpdateMethod: data.Entity.UpdateMethod,
+		Layers:       data.Entity.Layers,
+		GeneratedAt:  data.Timestamp,
+	}
+	for _, field := range data.Entity.Fields {
+		entity.Fields = append(entity.Fields, metadata.EntityField{
+			Name:     field.Name,
+			Type:     field.Type,
+			JSON:     field.JSONTag,
+			Required: field.Required,
+			Unique:   field.Unique,
+		})
+	}
+
+	if err := metadata.AddEntity(projectPath, entity); err != nil {
+		return err
+	}
+
+	if !data.Entity.HasLayer(metadata.LayerHTTP) {
+		return nil
+	}
+	return metadata.AddEndpoints(projectPath, crudEndpoints(data))
+}
+
+// recordCRUDFiles adds the files written or changed since the before snapshot to the
+// manifest in gophex.md, attributed to the CRUD generator for "gophex blame"
+func recordCRUDFiles(projectPath string, data *CRUDTemplateData, before map[string]metadata.FileRecord) error {
+	after, err := metadata.BuildFileManifest(projectPath)
+	if err != nil {
+		return err
+	}
+
+	records := make(map[string]metadata.FileRecord)
+	for relPath, record := range after {
+		if previous, ok := before[relPath]; ok && previous.Checksum == record.Checksum {
+			continue
+		}
+		record.Template = "crud/" + data.Entity.Name
+		record.GophexVersion = version.GetVersion()
+		record.GeneratedAt = data.Timestamp
+		records[relPath] = record
+	}
+
+	return metadata.RecordFiles(projectPath, records)
+}
+
 func createRoutesFile(projectPath string, data *CRUDTemplateData) error {
 	tmpl := `package routes
 
 import (
-	"github.com/gorilla/mux"
-	"{{.ModuleName}}/internal/api/handlers"
-	"{{.ModuleName}}/internal/domain/{{.Entity.Name}}"
+{{if .MinimalDeps}}	"net/http"
+
+{{else}}	"github.com/gorilla/mux"
+{{end}}	"{{.ModuleName}}/internal/domain/{{.Entity.Name}}"
 )
 
 // SetupRoutes configures all API routes
-func SetupRoutes({{.Entity.Name}}Service {{.Entity.Name}}.Service) *mux.Router {
-	router := mux.NewRouter()
-	
-	// Initialize handlers
-	{{.Entity.Name}}Handler := handlers.New{{title .Entity.Name}}Handler({{.Entity.Name}}Service)
-	
-	// {{title .Entity.Name}} routes
-	router.HandleFunc("/api/{{.Entity.PluralName}}", {{.Entity.Name}}Handler.Create{{title .Entity.Name}}).Methods("POST")
-	router.HandleFunc("/api/{{.Entity.PluralName}}", {{.Entity.Name}}Handler.List{{title .Entity.PluralName}}).Methods("GET")
-	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Get{{title .Entity.Name}}).Methods("GET")
-{{if or (eq .Entity.UpdateMethod "put") (eq .Entity.UpdateMethod "both")}}	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Update{{title .Entity.Name}}).Methods("PUT"){{end}}
-{{if or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Patch{{title .Entity.Name}}).Methods("PATCH"){{end}}
-	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Delete{{title .Entity.Name}}).Methods("DELETE")
-	
+func SetupRoutes({{.Entity.Name}}Service {{.Entity.Name}}.Service) {{if .MinimalDeps}}*http.ServeMux{{else}}*mux.Router{{end}} {
+	router := {{if .MinimalDeps}}http.NewServeMux(){{else}}mux.NewRouter(){{end}}
+
+	Register{{title .Entity.Name}}Routes(router, {{.Entity.Name}}Service)
+
 	return router
 }
 `
@@ -1044,6 +1219,8 @@ CREATE TABLE {{.Entity.PluralName}} (
 -- Create indexes
 {{range .Entity.Fields}}{{if .Unique}}CREATE UNIQUE INDEX idx_{{$.Entity.PluralName}}_{{.DBTag}} ON {{$.Entity.PluralName}}({{.DBTag}});
 {{end}}{{end}}
+{{range .Entity.ForeignKeys}}{{if not .Unique}}CREATE INDEX idx_{{$.Entity.PluralName}}_{{.DBTag}} ON {{$.Entity.PluralName}}({{.DBTag}});
+{{end}}{{end}}
 
 -- Create updated_at trigger
 CREATE OR REPLACE FUNCTION update_updated_at_column()
@@ -1160,6 +1337,8 @@ db.createCollection("{{.Entity.PluralName}}", {
 // Create indexes
 {{range .Entity.Fields}}{{if .Unique}}db.{{$.Entity.PluralName}}.createIndex({ "{{.JSONTag}}": 1 }, { unique: true });
 {{end}}{{end}}
+{{range .Entity.ForeignKeys}}{{if not .Unique}}db.{{$.Entity.PluralName}}.createIndex({ "{{.JSONTag}}": 1 });
+{{end}}{{end}}
 
 // Create compound indexes if needed
 // db.{{.Entity.PluralName}}.createIndex({ "field1": 1, "field2": 1 });
@@ -1539,11 +1718,12 @@ CREATE TABLE {{.Entity.PluralName}} (
 ## Next Steps
 
 1. **Run Database Migrations**: Execute the generated migration files
-2. **Start Your Server**: Run your API server
-3. **Test the Endpoints**: Use the curl examples above
-4. **Customize Business Logic**: Modify the service layer for your specific needs
-5. **Add Authentication**: Integrate with your authentication middleware
-6. **Add Validation**: Enhance field validation in the service layer
+2. **Run the Integration Tests**: ` + "`go test ./internal/api/routes/`" + ` needs no database
+3. **Start Your Server**: Run your API server
+4. **Test the Endpoints**: Use the curl examples above
+5. **Customize Business Logic**: Modify the service layer for your specific needs
+6. **Add Authentication**: Integrate with your authentication middleware
+7. **Add Validation**: Enhance field validation in the service layer
 
 ## File Structure
 
@@ -1551,13 +1731,23 @@ The CRUD generation created the following files:
 
 ` + "```" + `
 internal/domain/{{.Entity.Name}}/
-├── model.go       # Data models and request/response structs
-├── repository.go  # Database operations
-└── service.go     # Business logic
+├── model.go              # Data models, request/response structs and errors
+├── repository.go         # Repository interface
+├── {{if eq .DatabaseType "mongodb"}}mongo_repository.go   {{else}}sql_repository.go     {{end}}# Database operations
+├── memory_repository.go  # In-memory repository for tests
+└── service.go            # Business logic
 
 internal/api/handlers/
 └── {{.Entity.Name}}.go  # HTTP handlers
 
+internal/api/routes/
+├── {{.Entity.Name}}.go       # Register{{title .Entity.Name}}Routes
+└── {{.Entity.Name}}_test.go  # Integration tests against an in-process server
+
+internal/pkg/
+├── clock/clock.go  # Clock injected into services (clock.Fake in tests)
+└── idgen/idgen.go  # ID generator injected into repositories that assign IDs
+
 migrations/
 {{if eq .DatabaseType "mongodb"}}└── mongodb_init_{{.Entity.PluralName}}.js  # MongoDB initialization{{else}}├── [timestamp]_create_{{.Entity.PluralName}}_table.up.sql
 └── [timestamp]_create_{{.Entity.PluralName}}_table.down.sql{{end}}
@@ -1639,7 +1829,7 @@ Generated on: {{.Timestamp}}
 
 func showNextSteps(entity *CRUDEntity) {
 	fmt.Println("🎉 Next Steps:")
-	fmt.Printf("1. Run database migrations: `make migrate` or `./scripts/migrate.sh`\n")
+	fmt.Printf("1. Run database migrations: `make migrate` or `%s`\n", currentPlatform().scriptDisplayPath("migrate"))
 	fmt.Printf("2. Start your server: `go run cmd/api/main.go`\n")
 	fmt.Printf("3. Test your API endpoints:\n")
 	fmt.Printf("   - POST   /api/%s     (Create)\n", entity.PluralName)
@@ -1657,5 +1847,6 @@ func showNextSteps(entity *CRUDEntity) {
 	}
 
 	fmt.Printf("   - DELETE /api/%s/{id} (Delete)\n", entity.PluralName)
-	fmt.Printf("4. Check README_%s.md for detailed examples and documentation\n\n", entity.Name)
+	fmt.Printf("4. Run the integration tests: `go test ./internal/api/routes/`\n")
+	fmt.Printf("5. Check README_%s.md for detailed examples and documentation\n\n", entity.Name)
 }
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+func TestExecuteTemplateReportsTemplateErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		tmpl string
+	}{
+		{"parse", "package {{.Entity.Name"},
+		{"execute", "package {{.Missing.Name}}"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			filePath := filepath.Join(t.TempDir(), "model.go")
+			err := executeTemplate(test.tmpl, filePath, CRUDTemplateData{Entity: &CRUDEntity{Name: "product"}})
+			if failure.KindOf(err) != failure.KindTemplate {
+				t.Errorf("Expected a template error, got %v", err)
+			}
+			if failure.HintOf(err) != failure.TemplateBugHint {
+				t.Errorf("Expected the template bug hint, got %q", failure.HintOf(err))
+			}
+		})
+	}
+}
@@ -0,0 +1,541 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+)
+
+// zeroCheck returns the Go condition that holds when expr, of type goType, is empty.
+// Types without a meaningful empty value (bool) return "", so required checks skip them.
+func zeroCheck(expr, goType string) string {
+	switch goType {
+	case "string":
+		return expr + ` == ""`
+	case "int", "int32", "int64", "float64":
+		return expr + " == 0"
+	case "time.Time":
+		if strings.HasPrefix(expr, "*") {
+			return "(" + expr + ").IsZero()"
+		}
+		return expr + ".IsZero()"
+	case "[]string":
+		return "len(" + expr + ") == 0"
+	default:
+		return ""
+	}
+}
+
+// sampleValue returns a Go expression for a valid value of field in generated tests,
+// varying with the int variable n so that unique fields stay unique
+func sampleValue(field CRUDField, n string) string {
+	switch field.Type {
+	case "string":
+		return fmt.Sprintf("fmt.Sprintf(%q, %s)", field.JSONTag+"-%d", n)
+	case "int", "int32", "int64":
+		return n
+	case "float64":
+		return fmt.Sprintf("float64(%s) + 0.5", n)
+	case "bool":
+		return "true"
+	case "time.Time":
+		return fmt.Sprintf("time.Date(2025, time.January, %s, 12, 0, 0, 0, time.UTC)", n)
+	case "[]string":
+		return fmt.Sprintf("[]string{fmt.Sprintf(%q, %s)}", field.JSONTag+"-%d", n)
+	default:
+		return "nil"
+	}
+}
+
+// requestFields returns the fields clients send; the service sets CreatedAt and UpdatedAt
+func requestFields(fields []CRUDField) []CRUDField {
+	var request []CRUDField
+	for _, field := range fields {
+		if field.Name != "CreatedAt" && field.Name != "UpdatedAt" {
+			request = append(request, field)
+		}
+	}
+	return request
+}
+
+// firstStringField returns the first string request field, used to check round trips in tests
+func firstStringField(fields []CRUDField) *CRUDField {
+	for _, field := range requestFields(fields) {
+		if field.Type == "string" {
+			return &field
+		}
+	}
+	return nil
+}
+
+// firstField returns the field named name, or nil when the entity has none
+func firstField(fields []CRUDField, name string) *CRUDField {
+	for _, field := range fields {
+		if field.Name == name {
+			return &field
+		}
+	}
+	return nil
+}
+
+// firstRequiredField returns the first request field the service rejects when empty
+func firstRequiredField(fields []CRUDField) *CRUDField {
+	for _, field := range requestFields(fields) {
+		if field.Required && zeroCheck("x", field.Type) != "" {
+			return &field
+		}
+	}
+	return nil
+}
+
+// generateMemoryRepositoryFile generates an in-memory Repository for tests and prototyping
+func generateMemoryRepositoryFile(projectPath string, data *CRUDTemplateData) error {
+	tmpl := `{{$mongo := eq .DatabaseType "mongodb"}}{{$patch := or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}package {{.Entity.Name}}
+
+import (
+	"context"
+{{if or $mongo $patch}}	"fmt"
+{{end}}	"sort"
+	"sync"
+{{if and $patch (hasTimeFields .Entity.Fields)}}	"time"
+{{end}}
+{{if $mongo}}	"go.mongodb.org/mongo-driver/bson/primitive"
+
+{{end}}	"{{.ModuleName}}/internal/pkg/idgen"
+)
+
+// memoryRepository implements Repository in memory, for tests and local prototyping
+type memoryRepository struct {
+	mu    sync.RWMutex
+	items map[{{if $mongo}}string{{else}}int64{{end}}]{{title .Entity.Name}}
+	ids   idgen.Generator
+}
+
+// NewMemoryRepository creates an empty in-memory repository; ids assigns the IDs of new records
+func NewMemoryRepository(ids idgen.Generator) Repository {
+	return &memoryRepository{
+		items: make(map[{{if $mongo}}string{{else}}int64{{end}}]{{title .Entity.Name}}),
+		ids:   ids,
+	}
+}
+{{if $mongo}}
+// checkID rejects IDs that are not ObjectID hex strings, as the MongoDB repository does
+func checkID(id string) error {
+	if _, err := primitive.ObjectIDFromHex(id); err != nil {
+		return fmt.Errorf("%w: invalid ID format: %w", ErrInvalid, err)
+	}
+	return nil
+}
+{{end}}
+func (r *memoryRepository) Create(ctx context.Context, {{.Entity.Name}} *{{title .Entity.Name}}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	{{.Entity.Name}}.ID = r.ids.NewID()
+	r.items[{{.Entity.Name}}.ID{{if $mongo}}.Hex(){{end}}] = *{{.Entity.Name}}
+	return nil
+}
+
+func (r *memoryRepository) GetByID(ctx context.Context, id {{if $mongo}}string{{else}}int64{{end}}) (*{{title .Entity.Name}}, error) {
+{{if $mongo}}	if err := checkID(id); err != nil {
+		return nil, err
+	}
+
+{{end}}	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	{{.Entity.Name}}, ok := r.items[id]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return &{{.Entity.Name}}, nil
+}
+
+func (r *memoryRepository) List(ctx context.Context, page, pageSize int) ([]{{title .Entity.Name}}, int64, error) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	// Order by ID like the database repositories
+	ids := make([]{{if $mongo}}string{{else}}int64{{end}}, 0, len(r.items))
+	for id := range r.items {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	start := min(max(page-1, 0)*pageSize, len(ids))
+	end := min(start+pageSize, len(ids))
+
+	{{.Entity.PluralName}} := make([]{{title .Entity.Name}}, 0, end-start)
+	for _, id := range ids[start:end] {
+		{{.Entity.PluralName}} = append({{.Entity.PluralName}}, r.items[id])
+	}
+	return {{.Entity.PluralName}}, int64(len(ids)), nil
+}
+{{if or (eq .Entity.UpdateMethod "put") (eq .Entity.UpdateMethod "both")}}
+func (r *memoryRepository) Update(ctx context.Context, {{.Entity.Name}} *{{title .Entity.Name}}) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	id := {{.Entity.Name}}.ID{{if $mongo}}.Hex(){{end}}
+	if _, ok := r.items[id]; !ok {
+		return ErrNotFound
+	}
+	r.items[id] = *{{.Entity.Name}}
+	return nil
+}
+{{end}}{{if $patch}}
+func (r *memoryRepository) Patch(ctx context.Context, id {{if $mongo}}string{{else}}int64{{end}}, updates map[string]interface{}) error {
+{{if $mongo}}	if err := checkID(id); err != nil {
+		return err
+	}
+
+{{end}}	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	{{.Entity.Name}}, ok := r.items[id]
+	if !ok {
+		return ErrNotFound
+	}
+
+	// Updates are keyed by column name, as the service builds them for the database repositories
+	for column, value := range updates {
+		switch column {
+{{range .Entity.Fields}}		case "{{.DBTag}}":
+			v, ok := value.({{.Type}})
+			if !ok {
+				return fmt.Errorf("%w: %s must be {{.Type}}", ErrInvalid, column)
+			}
+			{{$.Entity.Name}}.{{.Name}} = v
+{{end}}		default:
+			return fmt.Errorf("%w: unknown field %s", ErrInvalid, column)
+		}
+	}
+
+	r.items[id] = {{.Entity.Name}}
+	return nil
+}
+{{end}}
+func (r *memoryRepository) Delete(ctx context.Context, id {{if $mongo}}string{{else}}int64{{end}}) error {
+{{if $mongo}}	if err := checkID(id); err != nil {
+		return err
+	}
+
+{{end}}	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if _, ok := r.items[id]; !ok {
+		return ErrNotFound
+	}
+	delete(r.items, id)
+	return nil
+}
+`
+
+	filePath := filepath.Join(projectPath, "internal", "domain", data.Entity.Name, "memory_repository.go")
+	return executeTemplate(tmpl, filePath, data)
+}
+
+// generateEntityRoutesFile generates Register<Entity>Routes, which adds the entity's
+// endpoints to the project router and to the router of the integration tests
+func generateEntityRoutesFile(projectPath string, data *CRUDTemplateData) error {
+	tmpl := `package routes
+
+import (
+{{if .MinimalDeps}}	"net/http"
+{{else}}	"github.com/gorilla/mux"
+{{end}}
+	"{{.ModuleName}}/internal/api/handlers"
+	"{{.ModuleName}}/internal/domain/{{.Entity.Name}}"
+)
+
+// Register{{title .Entity.Name}}Routes adds the {{.Entity.Name}} CRUD endpoints to router
+func Register{{title .Entity.Name}}Routes(router {{if .MinimalDeps}}*http.ServeMux{{else}}*mux.Router{{end}}, {{.Entity.Name}}Service {{.Entity.Name}}.Service) {
+	{{.Entity.Name}}Handler := handlers.New{{title .Entity.Name}}Handler({{.Entity.Name}}Service)
+{{if .MinimalDeps}}
+	router.HandleFunc("POST /api/{{.Entity.PluralName}}", {{.Entity.Name}}Handler.Create{{title .Entity.Name}})
+	router.HandleFunc("GET /api/{{.Entity.PluralName}}", {{.Entity.Name}}Handler.List{{title .Entity.PluralName}})
+	router.HandleFunc("GET /api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Get{{title .Entity.Name}})
+{{if or (eq .Entity.UpdateMethod "put") (eq .Entity.UpdateMethod "both")}}	router.HandleFunc("PUT /api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Update{{title .Entity.Name}})
+{{end}}{{if or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}	router.HandleFunc("PATCH /api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Patch{{title .Entity.Name}})
+{{end}}	router.HandleFunc("DELETE /api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Delete{{title .Entity.Name}})
+{{else}}
+	router.HandleFunc("/api/{{.Entity.PluralName}}", {{.Entity.Name}}Handler.Create{{title .Entity.Name}}).Methods("POST")
+	router.HandleFunc("/api/{{.Entity.PluralName}}", {{.Entity.Name}}Handler.List{{title .Entity.PluralName}}).Methods("GET")
+	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Get{{title .Entity.Name}}).Methods("GET")
+{{if or (eq .Entity.UpdateMethod "put") (eq .Entity.UpdateMethod "both")}}	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Update{{title .Entity.Name}}).Methods("PUT")
+{{end}}{{if or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Patch{{title .Entity.Name}}).Methods("PATCH")
+{{end}}	router.HandleFunc("/api/{{.Entity.PluralName}}/{id}", {{.Entity.Name}}Handler.Delete{{title .Entity.Name}}).Methods("DELETE")
+{{end}}}
+`
+
+	filePath := filepath.Join(projectPath, "internal", "api", "routes", data.Entity.Name+".go")
+	return executeTemplate(tmpl, filePath, data)
+}
+
+// generateIntegrationTestFile generates an httptest suite that serves the entity routes
+// from the in-memory repository and exercises every endpoint, error path and page boundary
+func generateIntegrationTestFile(projectPath string, data *CRUDTemplateData) error {
+	tmpl := `{{$put := or (eq .Entity.UpdateMethod "put") (eq .Entity.UpdateMethod "both")}}{{$patch := or (eq .Entity.UpdateMethod "patch") (eq .Entity.UpdateMethod "both")}}{{$title := title .Entity.Name}}package routes_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+{{if not .MinimalDeps}}	"github.com/gorilla/mux"
+{{end}}	"{{.ModuleName}}/internal/api/routes"
+	"{{.ModuleName}}/internal/domain/{{.Entity.Name}}"
+	"{{.ModuleName}}/internal/pkg/clock"
+	"{{.ModuleName}}/internal/pkg/idgen"
+)
+
+// {{.Entity.Name}}Epoch is the fake clock's starting time, so timestamps in responses are predictable
+var {{.Entity.Name}}Epoch = time.Date(2025, time.January, 1, 9, 0, 0, 0, time.UTC)
+
+// {{.Entity.Name}}Envelope is the JSON body written by the responses package
+type {{.Entity.Name}}Envelope struct {
+	Success bool
+	Message string
+	Data    json.RawMessage
+	Error   string
+}
+
+// new{{$title}}Server serves the {{.Entity.Name}} routes in-process, backed by the in-memory
+// repository, a fake clock and sequential IDs so that every response is deterministic
+func new{{$title}}Server(t *testing.T) (*httptest.Server, *clock.Fake) {
+	t.Helper()
+
+	fakeClock := clock.NewFake({{.Entity.Name}}Epoch)
+	service := {{.Entity.Name}}.NewService({{.Entity.Name}}.NewMemoryRepository(idgen.NewSequence()), fakeClock)
+
+	router := {{if .MinimalDeps}}http.NewServeMux(){{else}}mux.NewRouter(){{end}}
+	routes.Register{{$title}}Routes(router, service)
+
+	server := httptest.NewServer(router)
+	t.Cleanup(server.Close)
+	return server, fakeClock
+}
+
+// valid{{$title}} returns a create payload that passes validation; n varies the values
+func valid{{$title}}(n int) map[string]interface{} {
+	return map[string]interface{}{
+{{range requestFields .Entity.Fields}}		"{{.JSONTag}}": {{sampleValue . "n"}},
+{{end}}	}
+}
+
+// do{{$title}}Request sends body as JSON, or verbatim when it is a string, and decodes the response
+func do{{$title}}Request(t *testing.T, method, url string, body interface{}) (int, {{.Entity.Name}}Envelope) {
+	t.Helper()
+
+	var payload []byte
+	switch b := body.(type) {
+	case nil:
+	case string:
+		payload = []byte(b)
+	default:
+		var err error
+		if payload, err = json.Marshal(b); err != nil {
+			t.Fatalf("Failed to encode request body: %v", err)
+		}
+	}
+
+	req, err := http.NewRequest(method, url, bytes.NewReader(payload))
+	if err != nil {
+		t.Fatalf("Failed to build request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatalf("%s %s failed: %v", method, url, err)
+	}
+	defer resp.Body.Close()
+
+	var envelope {{.Entity.Name}}Envelope
+	if err := json.NewDecoder(resp.Body).Decode(&envelope); err != nil {
+		t.Fatalf("Failed to decode %s %s response: %v", method, url, err)
+	}
+	return resp.StatusCode, envelope
+}
+
+// create{{$title}} creates a {{.Entity.Name}} through the API and returns its ID
+func create{{$title}}(t *testing.T, server *httptest.Server, n int) string {
+	t.Helper()
+
+	status, envelope := do{{$title}}Request(t, http.MethodPost, server.URL+"/api/{{.Entity.PluralName}}", valid{{$title}}(n))
+	if status != http.StatusCreated {
+		t.Fatalf("Expected status 201 creating {{.Entity.Name}}, got %d: %s", status, envelope.Error)
+	}
+
+	var created struct{ ID interface{} }
+	if err := json.Unmarshal(envelope.Data, &created); err != nil {
+		t.Fatalf("Failed to decode created {{.Entity.Name}}: %v", err)
+	}
+	return fmt.Sprint(created.ID)
+}
+
+// {{.Entity.Name}}Field decodes one field of a {{.Entity.Name}} response
+func {{.Entity.Name}}Field(t *testing.T, envelope {{.Entity.Name}}Envelope, field string) interface{} {
+	t.Helper()
+
+	var fields map[string]interface{}
+	if err := json.Unmarshal(envelope.Data, &fields); err != nil {
+		t.Fatalf("Failed to decode {{.Entity.Name}}: %v", err)
+	}
+	return fields[field]
+}
+
+func Test{{$title}}CRUD(t *testing.T) {
+	server, fakeClock := new{{$title}}Server(t)
+
+	id := create{{$title}}(t, server, 1)
+	if id != "{{if eq .DatabaseType "mongodb"}}000000000000000000000001{{else}}1{{end}}" {
+		t.Errorf("Expected the first ID from the sequence, got %s", id)
+	}
+	item := server.URL + "/api/{{.Entity.PluralName}}/" + id
+
+	status, envelope := do{{$title}}Request(t, http.MethodGet, item, nil)
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200 for GET, got %d: %s", status, envelope.Error)
+	}
+{{with $created := firstField .Entity.Fields "CreatedAt"}}	if got := {{$.Entity.Name}}Field(t, envelope, "{{$created.JSONTag}}"); got != {{$.Entity.Name}}Epoch.Format(time.RFC3339) {
+		t.Errorf("Expected {{$created.JSONTag}} from the fake clock, got %v", got)
+	}
+{{end}}{{with firstStringField .Entity.Fields}}	if got := {{$.Entity.Name}}Field(t, envelope, "{{.JSONTag}}"); got != "{{.JSONTag}}-1" {
+		t.Errorf("Expected {{.JSONTag}} %q, got %v", "{{.JSONTag}}-1", got)
+	}
+{{end}}
+	fakeClock.Advance(time.Hour)
+{{if $put}}
+	status, envelope = do{{$title}}Request(t, http.MethodPut, item, valid{{$title}}(2))
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200 for PUT, got %d: %s", status, envelope.Error)
+	}
+{{with firstStringField .Entity.Fields}}	if got := {{$.Entity.Name}}Field(t, envelope, "{{.JSONTag}}"); got != "{{.JSONTag}}-2" {
+		t.Errorf("Expected {{.JSONTag}} %q after PUT, got %v", "{{.JSONTag}}-2", got)
+	}
+{{end}}{{end}}{{if $patch}}
+	status, envelope = do{{$title}}Request(t, http.MethodPatch, item, map[string]interface{}{ {{- with firstStringField .Entity.Fields}}"{{.JSONTag}}": "patched"{{end -}} })
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200 for PATCH, got %d: %s", status, envelope.Error)
+	}
+{{with firstStringField .Entity.Fields}}	if got := {{$.Entity.Name}}Field(t, envelope, "{{.JSONTag}}"); got != "patched" {
+		t.Errorf("Expected {{.JSONTag}} %q after PATCH, got %v", "patched", got)
+	}
+{{end}}{{end}}{{with $updated := firstField .Entity.Fields "UpdatedAt"}}	if got := {{$.Entity.Name}}Field(t, envelope, "{{$updated.JSONTag}}"); got != {{$.Entity.Name}}Epoch.Add(time.Hour).Format(time.RFC3339) {
+		t.Errorf("Expected {{$updated.JSONTag}} to follow the fake clock, got %v", got)
+	}
+{{end}}
+	status, envelope = do{{$title}}Request(t, http.MethodDelete, item, nil)
+	if status != http.StatusOK {
+		t.Fatalf("Expected status 200 for DELETE, got %d: %s", status, envelope.Error)
+	}
+
+	status, _ = do{{$title}}Request(t, http.MethodGet, item, nil)
+	if status != http.StatusNotFound {
+		t.Errorf("Expected status 404 after DELETE, got %d", status)
+	}
+}
+
+func Test{{$title}}ErrorPaths(t *testing.T) {
+	server, _ := new{{$title}}Server(t)
+	collection := server.URL + "/api/{{.Entity.PluralName}}"
+	missing := collection + "/{{if eq .DatabaseType "mongodb"}}000000000000000000000000{{else}}999999{{end}}"
+	invalid := collection + "/not-an-id"
+
+	tests := []struct {
+		name   string
+		method string
+		url    string
+		body   interface{}
+		status int
+	}{
+		{"create with malformed JSON", http.MethodPost, collection, "{", http.StatusBadRequest},
+{{with firstRequiredField .Entity.Fields}}		{"create without {{.JSONTag}}", http.MethodPost, collection, func() map[string]interface{} {
+			payload := valid{{$title}}(1)
+			delete(payload, "{{.JSONTag}}")
+			return payload
+		}(), http.StatusBadRequest},
+{{end}}		{"get with invalid ID", http.MethodGet, invalid, nil, http.StatusBadRequest},
+		{"get missing", http.MethodGet, missing, nil, http.StatusNotFound},
+{{if $put}}		{"replace with malformed JSON", http.MethodPut, missing, "{", http.StatusBadRequest},
+		{"replace missing", http.MethodPut, missing, valid{{$title}}(1), http.StatusNotFound},
+{{end}}{{if $patch}}		{"patch with malformed JSON", http.MethodPatch, missing, "{", http.StatusBadRequest},
+		{"patch missing", http.MethodPatch, missing, map[string]interface{}{}, http.StatusNotFound},
+{{end}}		{"delete with invalid ID", http.MethodDelete, invalid, nil, http.StatusBadRequest},
+		{"delete missing", http.MethodDelete, missing, nil, http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, envelope := do{{$title}}Request(t, test.method, test.url, test.body)
+			if status != test.status {
+				t.Errorf("Expected status %d, got %d: %s", test.status, status, envelope.Error)
+			}
+			if envelope.Success {
+				t.Error("Expected success to be false")
+			}
+		})
+	}
+}
+
+func Test{{$title}}Pagination(t *testing.T) {
+	server, _ := new{{$title}}Server(t)
+	for n := 1; n <= 3; n++ {
+		create{{$title}}(t, server, n)
+	}
+
+	tests := []struct {
+		name     string
+		query    string
+		count    int
+		page     int
+		pageSize int
+	}{
+		{"first page", "?page=1&page_size=2", 2, 1, 2},
+		{"last partial page", "?page=2&page_size=2", 1, 2, 2},
+		{"page past the end", "?page=5&page_size=2", 0, 5, 2},
+		{"defaults", "", 3, 1, 10},
+		{"zero page falls back to the first", "?page=0", 3, 1, 10},
+		{"non-numeric values fall back to defaults", "?page=abc&page_size=xyz", 3, 1, 10},
+		{"page size above the limit falls back to the default", "?page_size=101", 3, 1, 10},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			status, envelope := do{{$title}}Request(t, http.MethodGet, server.URL+"/api/{{.Entity.PluralName}}"+test.query, nil)
+			if status != http.StatusOK {
+				t.Fatalf("Expected status 200, got %d: %s", status, envelope.Error)
+			}
+
+			var list struct {
+				Items    []json.RawMessage ` + "`json:\"{{.Entity.PluralName}}\"`" + `
+				Total    int64             ` + "`json:\"total\"`" + `
+				Page     int               ` + "`json:\"page\"`" + `
+				PageSize int               ` + "`json:\"page_size\"`" + `
+			}
+			if err := json.Unmarshal(envelope.Data, &list); err != nil {
+				t.Fatalf("Failed to decode list: %v", err)
+			}
+
+			if len(list.Items) != test.count {
+				t.Errorf("Expected %d {{.Entity.PluralName}}, got %d", test.count, len(list.Items))
+			}
+			if list.Total != 3 {
+				t.Errorf("Expected total 3, got %d", list.Total)
+			}
+			if list.Page != test.page || list.PageSize != test.pageSize {
+				t.Errorf("Expected page %d of size %d, got page %d of size %d", test.page, test.pageSize, list.Page, list.PageSize)
+			}
+		})
+	}
+}
+`
+
+	filePath := filepath.Join(projectPath, "internal", "api", "routes", data.Entity.Name+"_test.go")
+	return executeTemplate(tmpl, filePath, data)
+}
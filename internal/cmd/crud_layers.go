@@ -0,0 +1,145 @@
+package cmd
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+)
+
+// layerDescriptions explains each layer in the layer selection prompt
+var layerDescriptions = map[string]string{
+	metadata.LayerDomain:     "Model, repository interface and service",
+	metadata.LayerRepository: "Database and in-memory repositories, migrations",
+	metadata.LayerHTTP:       "Handlers, routes and integration tests",
+}
+
+// HasLayer reports whether layer should be generated for the entity
+func (e *CRUDEntity) HasLayer(layer string) bool {
+	return metadata.EntityInfo{Layers: e.Layers}.HasLayer(layer)
+}
+
+// crudLayers tracks the layers a CRUD generation writes and those present once it finishes
+type crudLayers struct {
+	generate metadata.EntityInfo // layers written by this run
+	present  metadata.EntityInfo // layers written by this run or recorded from an earlier one
+}
+
+// complete reports whether every layer of the entity exists after this run
+func (l *crudLayers) complete() bool {
+	return len(l.present.MissingLayers()) == 0
+}
+
+// resolveCRUDLayers combines the entity's selected layers with those recorded in gophex.md,
+// so that a partial entity can be completed by generating only its missing layers
+func resolveCRUDLayers(projectPath string, entity *CRUDEntity) (*crudLayers, error) {
+	for _, layer := range entity.Layers {
+		if _, ok := layerDescriptions[layer]; !ok {
+			return nil, fmt.Errorf("unknown layer %q (choose from: %s)", layer, strings.Join(metadata.AllLayers, ", "))
+		}
+	}
+
+	layers := &crudLayers{
+		generate: metadata.EntityInfo{Layers: entity.Layers},
+		present:  metadata.EntityInfo{Layers: entity.Layers},
+	}
+
+	if len(entity.Layers) > 0 {
+		projectMetadata, err := metadata.LoadMetadata(projectPath)
+		if err != nil {
+			return nil, fmt.Errorf("failed to load project metadata: %w", err)
+		}
+		if recorded, ok := metadata.FindEntity(projectMetadata, entity.Name); ok {
+			layers.present.Layers = metadata.MergeLayers(recorded.Layers, entity.Layers)
+		}
+	}
+
+	if !layers.present.HasLayer(metadata.LayerDomain) {
+		return nil, fmt.Errorf("the %s layer is required: select it or generate it for %s first", metadata.LayerDomain, entity.Name)
+	}
+
+	return layers, nil
+}
+
+// crudLayerFiles lists the files generated for the entity's selected layers
+func crudLayerFiles(entity *CRUDEntity, databaseType string) []string {
+	domainDir := filepath.Join("internal", "domain", entity.Name)
+
+	var files []string
+	if entity.HasLayer(metadata.LayerDomain) {
+		files = append(files,
+			filepath.Join(domainDir, "model.go"),
+			filepath.Join(domainDir, "repository.go"),
+			filepath.Join(domainDir, "service.go"),
+			filepath.Join("internal", "pkg", "clock", "clock.go"),
+		)
+	}
+	if entity.HasLayer(metadata.LayerRepository) {
+		files = append(files,
+			filepath.Join(domainDir, databaseRepositoryFile(databaseType)),
+			filepath.Join(domainDir, "memory_repository.go"),
+			filepath.Join("internal", "pkg", "idgen", "idgen.go"),
+			"migrations/",
+		)
+	}
+	if entity.HasLayer(metadata.LayerHTTP) {
+		files = append(files,
+			filepath.Join("internal", "api", "handlers", entity.Name+".go"),
+			filepath.Join("internal", "api", "routes", entity.Name+".go"),
+			filepath.Join("internal", "api", "routes", entity.Name+"_test.go"),
+		)
+	}
+	return append(files, fmt.Sprintf("README_%s.md", entity.Name))
+}
+
+// selectLayers asks which layers to generate. Layers already recorded for the entity
+// are left unselected, so rerunning the wizard fills in the rest of a partial entity.
+func selectLayers(projectPath string, entity *CRUDEntity) error {
+	defaults := metadata.AllLayers
+	if projectMetadata, err := metadata.LoadMetadata(projectPath); err == nil {
+		if recorded, ok := metadata.FindEntity(projectMetadata, entity.Name); ok {
+			if missing := recorded.MissingLayers(); len(missing) > 0 {
+				fmt.Printf("📦 %s already has the %s layer(s)\n\n", entity.Name, strings.Join(recorded.Layers, ", "))
+				defaults = missing
+			}
+		}
+	}
+
+	options := make([]string, len(metadata.AllLayers))
+	var selectedDefaults []string
+	for i, layer := range metadata.AllLayers {
+		options[i] = fmt.Sprintf("%s - %s", layer, layerDescriptions[layer])
+		for _, def := range defaults {
+			if def == layer {
+				selectedDefaults = append(selectedDefaults, options[i])
+			}
+		}
+	}
+
+	var selected []string
+	layerPrompt := &survey.MultiSelect{
+		Message: "Which layers should be generated?",
+		Options: options,
+		Default: selectedDefaults,
+		Help:    "Skip http to plug the domain and repository into an existing transport; missing layers can be generated later",
+	}
+
+	if err := askOne(layerPrompt, &selected, survey.WithValidator(survey.Required)); err != nil {
+		if isUserInterrupt(err) {
+			return ErrUserQuit
+		}
+		return fmt.Errorf("layer selection failed: %w", err)
+	}
+
+	entity.Layers = nil
+	if len(selected) < len(metadata.AllLayers) {
+		for _, option := range selected {
+			entity.Layers = append(entity.Layers, strings.SplitN(option, " - ", 2)[0])
+		}
+	}
+
+	return nil
+}
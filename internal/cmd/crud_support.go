@@ -0,0 +1,172 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// generateSupportPackages writes the clock and idgen packages the generated services
+// and repositories depend on. Existing files are kept so that later entities reuse them.
+func generateSupportPackages(projectPath string, data *CRUDTemplateData) error {
+	packages := []struct {
+		dir  string
+		tmpl string
+	}{
+		{filepath.Join(projectPath, "internal", "pkg", "clock"), clockTemplate},
+		{filepath.Join(projectPath, "internal", "pkg", "idgen"), idgenTemplate},
+	}
+
+	for _, pkg := range packages {
+		filePath := filepath.Join(pkg.dir, filepath.Base(pkg.dir)+".go")
+		if _, err := os.Stat(filePath); err == nil {
+			continue
+		}
+
+		if err := os.MkdirAll(pkg.dir, 0755); err != nil {
+			return fmt.Errorf("failed to create directory %s: %w", pkg.dir, err)
+		}
+		if err := executeTemplate(pkg.tmpl, filePath, data); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// clockTemplate is the generated clock package: services take a Clock instead of calling time.Now
+const clockTemplate = `// Package clock abstracts the current time. Services take a Clock instead of
+// calling time.Now, so tests can fix the time and advance it explicitly.
+package clock
+
+import (
+	"sync"
+	"time"
+)
+
+// Clock tells the current time
+type Clock interface {
+	Now() time.Time
+}
+
+type systemClock struct{}
+
+// System returns the Clock backed by time.Now, for production wiring
+func System() Clock {
+	return systemClock{}
+}
+
+func (systemClock) Now() time.Time {
+	return time.Now()
+}
+
+// Fake is a Clock for tests that only moves when told to
+type Fake struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+// NewFake returns a Fake clock reading now
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+// Now returns the fake time
+func (f *Fake) Now() time.Time {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.now
+}
+
+// Advance moves the fake time forward by d
+func (f *Fake) Advance(d time.Duration) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = f.now.Add(d)
+}
+
+// Set moves the fake time to now
+func (f *Fake) Set(now time.Time) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.now = now
+}
+`
+
+// idgenTemplate is the generated idgen package: repositories that assign IDs themselves
+// take a Generator, while SQL databases keep assigning IDs through SERIAL columns
+const idgenTemplate = `// Package idgen issues IDs for records whose IDs are not assigned by the database.
+// Repositories take a Generator instead of creating IDs inline, so tests can predict them.
+package idgen
+
+import (
+{{if eq .DatabaseType "mongodb"}}	"encoding/binary"
+	"sync"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+{{else}}	"sync"
+{{end}})
+{{if eq .DatabaseType "mongodb"}}
+// Generator issues IDs for new records
+type Generator interface {
+	NewID() primitive.ObjectID
+}
+
+type objectIDs struct{}
+
+// ObjectIDs returns the Generator of driver ObjectIDs, for production wiring
+func ObjectIDs() Generator {
+	return objectIDs{}
+}
+
+func (objectIDs) NewID() primitive.ObjectID {
+	return primitive.NewObjectID()
+}
+
+// Sequence issues predictable ObjectIDs ending in 1, 2, 3... for tests
+type Sequence struct {
+	mu   sync.Mutex
+	last uint64
+}
+
+// NewSequence creates a sequence whose first ID is 000000000000000000000001
+func NewSequence() *Sequence {
+	return &Sequence{}
+}
+
+// NewID returns the next ID in the sequence
+func (s *Sequence) NewID() primitive.ObjectID {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.last++
+	var id primitive.ObjectID
+	binary.BigEndian.PutUint64(id[4:], s.last)
+	return id
+}
+{{else}}
+// Generator issues IDs for new records
+type Generator interface {
+	NewID() int64
+}
+
+// Sequence issues increasing IDs starting at 1, like a SERIAL column
+type Sequence struct {
+	mu   sync.Mutex
+	last int64
+}
+
+// NewSequence creates a sequence whose first ID is 1
+func NewSequence() *Sequence {
+	return &Sequence{}
+}
+
+// NewID returns the next ID in the sequence
+func (s *Sequence) NewID() int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.last++
+	return s.last
+}
+{{end}}`
@@ -2,10 +2,14 @@ package cmd
 
 import (
 	"fmt"
+	"go/format"
 	"regexp"
+	"strconv"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/buildwithhp/gophex/internal/presets"
 )
 
 // CRUDField represents a field in the entity
@@ -21,10 +25,40 @@ type CRUDField struct {
 
 // CRUDEntity represents the entity to generate CRUD for
 type CRUDEntity struct {
-	Name         string
-	PluralName   string
-	Fields       []CRUDField
-	UpdateMethod string // "put", "patch", or "both"
+	Name          string
+	PluralName    string
+	Fields        []CRUDField
+	Relationships []presets.Relationship // from the selected preset, if any
+	UpdateMethod  string                 // "put", "patch", or "both"
+	Layers        []string               // metadata.Layer* values to generate; empty generates every layer
+}
+
+// ForeignKeys returns the fields holding the IDs of entities this one belongs to
+func (e *CRUDEntity) ForeignKeys() []CRUDField {
+	var keys []CRUDField
+	for _, rel := range e.Relationships {
+		if rel.Kind != presets.BelongsTo {
+			continue
+		}
+		for _, field := range e.Fields {
+			if field.Name == rel.Field {
+				keys = append(keys, field)
+				break
+			}
+		}
+	}
+	return keys
+}
+
+// crudFieldTypes are the Go types offered for entity fields
+var crudFieldTypes = []string{
+	"string - Text data",
+	"int - Integer numbers",
+	"int64 - Large integer numbers",
+	"float64 - Decimal numbers",
+	"bool - True/false values",
+	"time.Time - Date and time",
+	"[]string - Array of strings",
 }
 
 // UpdateMethodChoice represents the update method selection
@@ -42,14 +76,15 @@ func RunCRUDWizard(projectPath string) error {
 	fmt.Println()
 
 	entity := &CRUDEntity{}
+	library := loadPresetLibrary(projectPath)
 
 	// Step 1: Entity Selection
-	if err := selectEntity(entity); err != nil {
+	if err := selectEntity(entity, library); err != nil {
 		return err
 	}
 
 	// Step 2: Field Definition
-	if err := defineFields(entity); err != nil {
+	if err := defineFields(entity, library); err != nil {
 		return err
 	}
 
@@ -67,29 +102,36 @@ func RunCRUDWizard(projectPath string) error {
 	return generateCRUDCode(projectPath, entity)
 }
 
+// loadPresetLibrary loads the entity presets, warning about template packs that fail to load
+func loadPresetLibrary(projectPath string) *presets.Library {
+	library, err := presets.Load(projectPath)
+	if err != nil {
+		fmt.Printf("⚠️  Some template packs were skipped: %v\n\n", err)
+	}
+	return library
+}
+
 // selectEntity handles entity name selection
-func selectEntity(entity *CRUDEntity) error {
+func selectEntity(entity *CRUDEntity, library *presets.Library) error {
 	fmt.Println("📝 Step 1: Entity Selection")
 	fmt.Println("What would you like to create CRUD operations for?")
 	fmt.Println()
 
-	// Provide common examples
-	commonEntities := []string{
-		"user - User management (name, email, password)",
-		"post - Blog posts or articles (title, content, author)",
-		"product - E-commerce products (name, price, description)",
-		"task - Todo or task management (title, description, status)",
-		"custom - I'll define my own entity",
+	// Offer the preset library, then a custom entity
+	var entityOptions []string
+	for _, preset := range library.All() {
+		entityOptions = append(entityOptions, presetOption(preset))
 	}
+	entityOptions = append(entityOptions, "custom - I'll define my own entity")
 
 	var selected string
 	entityPrompt := &survey.Select{
 		Message: "Choose an entity type:",
-		Options: commonEntities,
-		Help:    "Select a common entity or choose 'custom' to define your own",
+		Options: entityOptions,
+		Help:    "Select a preset entity or choose 'custom' to define your own",
 	}
 
-	if err := survey.AskOne(entityPrompt, &selected); err != nil {
+	if err := askOne(entityPrompt, &selected); err != nil {
 		if isUserInterrupt(err) {
 			return nil
 		}
@@ -100,11 +142,12 @@ func selectEntity(entity *CRUDEntity) error {
 		// Custom entity name
 		var customName string
 		namePrompt := &survey.Input{
-			Message: "Enter your entity name (singular, e.g., 'book', 'order'):",
+			Message: "Enter your entity name (singular, e.g., 'book', 'event'):",
 			Help:    "Use lowercase, singular form. We'll generate the plural automatically.",
+			Default: "item",
 		}
 
-		if err := survey.AskOne(namePrompt, &customName); err != nil {
+		if err := askOne(namePrompt, &customName); err != nil {
 			if isUserInterrupt(err) {
 				return nil
 			}
@@ -123,19 +166,36 @@ func selectEntity(entity *CRUDEntity) error {
 	}
 
 	entity.PluralName = pluralize(entity.Name)
+	entity.Relationships = nil
+	if preset, ok := library.Lookup(entity.Name); ok {
+		// Copy, since renaming a field updates the relationships that refer to it
+		entity.Relationships = append([]presets.Relationship(nil), preset.Relationships...)
+	}
 
-	fmt.Printf("✅ Selected entity: %s (plural: %s)\n\n", entity.Name, entity.PluralName)
+	fmt.Printf("✅ Selected entity: %s (plural: %s)\n", entity.Name, entity.PluralName)
+	for _, rel := range entity.Relationships {
+		fmt.Printf("   🔗 %s %s\n", entity.Name, rel)
+	}
+	fmt.Println()
 	return nil
 }
 
+// presetOption formats a preset for the entity prompt, naming the pack it came from
+func presetOption(preset presets.Preset) string {
+	if preset.Source == presets.BuiltinSource {
+		return fmt.Sprintf("%s - %s", preset.Name, preset.Summary)
+	}
+	return fmt.Sprintf("%s - %s [%s pack]", preset.Name, preset.Summary, preset.Source)
+}
+
 // defineFields handles field definition
-func defineFields(entity *CRUDEntity) error {
+func defineFields(entity *CRUDEntity, library *presets.Library) error {
 	fmt.Println("🏗️  Step 2: Field Definition")
 	fmt.Printf("Let's define the fields for your %s entity.\n", entity.Name)
 	fmt.Println()
 
 	// Add common fields based on entity type
-	entity.Fields = getCommonFields(entity.Name)
+	entity.Fields = getCommonFields(library, entity.Name)
 
 	if len(entity.Fields) > 0 {
 		fmt.Printf("I've added some common fields for %s:\n", entity.Name)
@@ -155,7 +215,7 @@ func defineFields(entity *CRUDEntity) error {
 			Help: "You can modify or add more fields in the next step",
 		}
 
-		if err := survey.AskOne(commonPrompt, &useCommon); err != nil {
+		if err := askOne(commonPrompt, &useCommon); err != nil {
 			if isUserInterrupt(err) {
 				return nil
 			}
@@ -186,7 +246,7 @@ func defineFields(entity *CRUDEntity) error {
 				},
 			}
 
-			if err := survey.AskOne(addPrompt, &addMore); err != nil {
+			if err := askOne(addPrompt, &addMore); err != nil {
 				if isUserInterrupt(err) {
 					return nil
 				}
@@ -217,15 +277,7 @@ func defineFields(entity *CRUDEntity) error {
 
 	fmt.Printf("\n📋 Final fields for %s:\n", entity.Name)
 	for _, field := range entity.Fields {
-		required := ""
-		if field.Required {
-			required = " (required)"
-		}
-		unique := ""
-		if field.Unique {
-			unique = " (unique)"
-		}
-		fmt.Printf("  - %s: %s%s%s\n", field.Name, field.Type, required, unique)
+		fmt.Printf("  - %s\n", formatField(field))
 	}
 	fmt.Println()
 
@@ -278,7 +330,7 @@ func selectUpdateMethod(entity *CRUDEntity) error {
 		Help:    "This affects how your API will handle resource updates",
 	}
 
-	if err := survey.AskOne(methodPrompt, &selected); err != nil {
+	if err := askOne(methodPrompt, &selected); err != nil {
 		if isUserInterrupt(err) {
 			return nil
 		}
@@ -297,68 +349,341 @@ func selectUpdateMethod(entity *CRUDEntity) error {
 	return nil
 }
 
-// previewAndConfirm shows what will be generated
+// previewAndConfirm shows what will be generated, letting the user edit the fields until they confirm
 func previewAndConfirm(entity *CRUDEntity) error {
-	fmt.Println("👀 Step 4: Preview")
-	fmt.Printf("Here's what will be generated for your %s entity:\n\n", entity.Name)
+	for {
+		showCRUDPreview(entity)
+
+		var confirm string
+		confirmPrompt := &survey.Select{
+			Message: "Generate these CRUD operations?",
+			Options: []string{
+				"Yes - Generate CRUD operations",
+				"Edit - Change fields before generating",
+				"No - Cancel generation",
+				"Quit",
+			},
+			Help: "Edit lets you rename, retype, reorder or delete fields and toggle required/unique",
+		}
+
+		if err := askOne(confirmPrompt, &confirm); err != nil {
+			if isUserInterrupt(err) {
+				return nil
+			}
+			return fmt.Errorf("confirmation prompt failed: %w", err)
+		}
+
+		if confirm == "Quit" {
+			return ErrUserQuit
+		}
+
+		if strings.HasPrefix(confirm, "Edit") {
+			if err := editFields(entity); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if confirm[:2] == "No" {
+			fmt.Println("❌ CRUD generation cancelled")
+			return ErrReturnToMenu
+		}
+
+		return nil
+	}
+}
+
+// showCRUDPreview prints the fields, model, endpoints and files that will be generated
+func showCRUDPreview(entity *CRUDEntity) {
+	var preview strings.Builder
+	fmt.Fprintln(&preview, "👀 Step 4: Preview")
+	fmt.Fprintf(&preview, "Here's what will be generated for your %s entity:\n\n", entity.Name)
+
+	// Show fields in generation order
+	fmt.Fprintln(&preview, "🧱 Fields:")
+	for i, field := range entity.Fields {
+		fmt.Fprintf(&preview, "  %d. %s\n", i+1, formatField(field))
+	}
+	fmt.Fprintln(&preview)
+
+	if len(entity.Relationships) > 0 {
+		fmt.Fprintln(&preview, "🔗 Relationships:")
+		for _, rel := range entity.Relationships {
+			fmt.Fprintf(&preview, "  %s %s\n", entity.Name, rel)
+		}
+		fmt.Fprintln(&preview)
+	}
+
+	// Show the model struct as it will be generated
+	fmt.Fprintln(&preview, "🧩 Model:")
+	fmt.Fprintln(&preview, "```go")
+	fmt.Fprint(&preview, modelPreview(entity))
+	fmt.Fprintln(&preview, "```")
+	fmt.Fprintln(&preview)
 
 	// Show endpoints
-	fmt.Println("📡 API Endpoints:")
-	fmt.Printf("  GET    /api/%s     - List %s with pagination\n", entity.PluralName, entity.PluralName)
-	fmt.Printf("  GET    /api/%s/{id} - Get %s by ID\n", entity.PluralName, entity.Name)
-	fmt.Printf("  POST   /api/%s     - Create new %s\n", entity.PluralName, entity.Name)
+	fmt.Fprintln(&preview, "📡 API Endpoints:")
+	fmt.Fprintf(&preview, "  GET    /api/%s     - List %s with pagination\n", entity.PluralName, entity.PluralName)
+	fmt.Fprintf(&preview, "  GET    /api/%s/{id} - Get %s by ID\n", entity.PluralName, entity.Name)
+	fmt.Fprintf(&preview, "  POST   /api/%s     - Create new %s\n", entity.PluralName, entity.Name)
 
 	switch entity.UpdateMethod {
 	case "put":
-		fmt.Printf("  PUT    /api/%s/{id} - Complete %s replacement (all fields required)\n", entity.PluralName, entity.Name)
+		fmt.Fprintf(&preview, "  PUT    /api/%s/{id} - Complete %s replacement (all fields required)\n", entity.PluralName, entity.Name)
 	case "patch":
-		fmt.Printf("  PATCH  /api/%s/{id} - Partial %s update (only provided fields)\n", entity.PluralName, entity.Name)
+		fmt.Fprintf(&preview, "  PATCH  /api/%s/{id} - Partial %s update (only provided fields)\n", entity.PluralName, entity.Name)
 	case "both":
-		fmt.Printf("  PUT    /api/%s/{id} - Complete %s replacement (all fields required)\n", entity.PluralName, entity.Name)
-		fmt.Printf("  PATCH  /api/%s/{id} - Partial %s update (only provided fields)\n", entity.PluralName, entity.Name)
+		fmt.Fprintf(&preview, "  PUT    /api/%s/{id} - Complete %s replacement (all fields required)\n", entity.PluralName, entity.Name)
+		fmt.Fprintf(&preview, "  PATCH  /api/%s/{id} - Partial %s update (only provided fields)\n", entity.PluralName, entity.Name)
 	}
 
-	fmt.Printf("  DELETE /api/%s/{id} - Delete %s\n\n", entity.PluralName, entity.Name)
+	fmt.Fprintf(&preview, "  DELETE /api/%s/{id} - Delete %s\n\n", entity.PluralName, entity.Name)
 
 	// Show files that will be created
-	fmt.Println("📁 Files to be created/updated:")
-	fmt.Printf("  internal/domain/%s/model.go       - Data model\n", entity.Name)
-	fmt.Printf("  internal/domain/%s/repository.go  - Database operations\n", entity.Name)
-	fmt.Printf("  internal/domain/%s/service.go     - Business logic\n", entity.Name)
-	fmt.Printf("  internal/api/handlers/%s.go       - HTTP handlers\n", entity.Name)
-	fmt.Printf("  internal/api/routes/routes.go     - Route registration (updated)\n")
-	fmt.Printf("  migrations/                       - Database migration files\n")
-	fmt.Printf("  README_%s.md                      - Documentation and examples\n\n", entity.Name)
-
-	var confirm string
-	confirmPrompt := &survey.Select{
-		Message: "Generate these CRUD operations?",
-		Options: []string{
-			"Yes - Generate CRUD operations",
-			"No - Cancel generation",
-			"Quit",
-		},
+	fmt.Fprintln(&preview, "📁 Files to be created/updated:")
+	fmt.Fprintf(&preview, "  internal/domain/%s/model.go       - Data model\n", entity.Name)
+	fmt.Fprintf(&preview, "  internal/domain/%s/repository.go  - Repository interface\n", entity.Name)
+	fmt.Fprintf(&preview, "  internal/domain/%s/*_repository.go - Database and in-memory repositories\n", entity.Name)
+	fmt.Fprintf(&preview, "  internal/domain/%s/service.go     - Business logic\n", entity.Name)
+	fmt.Fprintf(&preview, "  internal/api/handlers/%s.go       - HTTP handlers\n", entity.Name)
+	fmt.Fprintf(&preview, "  internal/api/routes/%s.go         - Route registration\n", entity.Name)
+	fmt.Fprintf(&preview, "  internal/api/routes/%s_test.go    - Integration tests (httptest)\n", entity.Name)
+	fmt.Fprintf(&preview, "  migrations/                       - Database migration files\n")
+	fmt.Fprintf(&preview, "  README_%s.md                      - Documentation and examples\n\n", entity.Name)
+
+	showPaged(preview.String())
+}
+
+// modelPreview returns the Go struct the CRUD generator writes for the entity's model. The ID
+// field is left out because its type depends on the project's database.
+func modelPreview(entity *CRUDEntity) string {
+	var model strings.Builder
+	fmt.Fprintf(&model, "// %s represents a %s entity\n", strings.Title(entity.Name), entity.Name)
+	fmt.Fprintf(&model, "type %s struct {\n", strings.Title(entity.Name))
+	for _, field := range entity.Fields {
+		fmt.Fprintf(&model, "\t%s %s `json:\"%s\" db:\"%s\"`\n", field.Name, field.Type, field.JSONTag, field.DBTag)
+	}
+	model.WriteString("}\n")
+
+	formatted, err := format.Source([]byte(model.String()))
+	if err != nil {
+		return model.String()
+	}
+	return string(formatted)
+}
+
+// editFields lets the user fix the entity's fields from the preview without restarting the wizard
+func editFields(entity *CRUDEntity) error {
+	for {
+		options := make([]string, 0, len(entity.Fields)+3)
+		for i, field := range entity.Fields {
+			options = append(options, fmt.Sprintf("%d. %s", i+1, formatField(field)))
+		}
+		options = append(options, "Add a new field", "Done - Back to preview", "Quit")
+
+		var selected string
+		fieldPrompt := &survey.Select{
+			Message: "Which field would you like to edit?",
+			Options: options,
+			Help:    "Fields are generated in the order shown",
+		}
+
+		if err := askOne(fieldPrompt, &selected); err != nil {
+			if isUserInterrupt(err) {
+				return ErrUserQuit
+			}
+			return fmt.Errorf("field selection failed: %w", err)
+		}
+
+		switch {
+		case selected == "Quit":
+			return ErrUserQuit
+		case strings.HasPrefix(selected, "Done"):
+			if len(entity.Fields) == 0 {
+				fmt.Println("⚠️  At least one field is required")
+				continue
+			}
+			fmt.Println()
+			return nil
+		case selected == "Add a new field":
+			field, err := defineField()
+			if err != nil {
+				fmt.Printf("❌ %v\n", err)
+				continue
+			}
+			if fieldNameTaken(entity.Fields, field.Name, -1) {
+				fmt.Printf("❌ A field named %s already exists\n", field.Name)
+				continue
+			}
+			entity.Fields = append(entity.Fields, field)
+			fmt.Printf("✅ Added field: %s (%s)\n", field.Name, field.Type)
+		default:
+			index, err := strconv.Atoi(strings.SplitN(selected, ".", 2)[0])
+			if err != nil {
+				return fmt.Errorf("unexpected field option: %s", selected)
+			}
+			if err := editField(entity, index-1); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// editField applies one change to the field at index
+func editField(entity *CRUDEntity, index int) error {
+	field := &entity.Fields[index]
+
+	actions := []string{
+		"Rename",
+		"Change type",
+		fmt.Sprintf("Toggle required (currently %s)", yesNo(field.Required)),
+		fmt.Sprintf("Toggle unique (currently %s)", yesNo(field.Unique)),
+	}
+	if index > 0 {
+		actions = append(actions, "Move up")
+	}
+	if index < len(entity.Fields)-1 {
+		actions = append(actions, "Move down")
+	}
+	actions = append(actions, "Delete", "Back")
+
+	var action string
+	actionPrompt := &survey.Select{
+		Message: fmt.Sprintf("What would you like to change about %s?", field.Name),
+		Options: actions,
 	}
 
-	if err := survey.AskOne(confirmPrompt, &confirm); err != nil {
+	if err := askOne(actionPrompt, &action); err != nil {
 		if isUserInterrupt(err) {
+			return ErrUserQuit
+		}
+		return fmt.Errorf("field action selection failed: %w", err)
+	}
+
+	switch {
+	case action == "Rename":
+		var name string
+		namePrompt := &survey.Input{
+			Message: "New field name:",
+			Default: field.Name,
+			Help:    "JSON and database tags are regenerated from the new name",
+		}
+		if err := askOne(namePrompt, &name); err != nil {
+			return fmt.Errorf("field name input failed: %w", err)
+		}
+		oldName := field.Name
+		if err := renameField(entity, index, name); err != nil {
+			fmt.Printf("❌ %v\n", err)
 			return nil
 		}
-		return fmt.Errorf("confirmation prompt failed: %w", err)
+		fmt.Printf("✅ Renamed %s to %s\n", oldName, name)
+	case action == "Change type":
+		var selectedType string
+		typePrompt := &survey.Select{
+			Message: fmt.Sprintf("New type for %s:", field.Name),
+			Options: crudFieldTypes,
+			Default: fieldTypeOption(field.Type),
+		}
+		if err := askOne(typePrompt, &selectedType); err != nil {
+			return fmt.Errorf("field type selection failed: %w", err)
+		}
+		field.Type = strings.Split(selectedType, " - ")[0]
+		fmt.Printf("✅ %s is now %s\n", field.Name, field.Type)
+	case strings.HasPrefix(action, "Toggle required"):
+		field.Required = !field.Required
+		fmt.Printf("✅ %s required: %s\n", field.Name, yesNo(field.Required))
+	case strings.HasPrefix(action, "Toggle unique"):
+		field.Unique = !field.Unique
+		fmt.Printf("✅ %s unique: %s\n", field.Name, yesNo(field.Unique))
+	case action == "Move up":
+		entity.Fields = moveField(entity.Fields, index, index-1)
+	case action == "Move down":
+		entity.Fields = moveField(entity.Fields, index, index+1)
+	case action == "Delete":
+		name := field.Name
+		entity.Fields = append(entity.Fields[:index], entity.Fields[index+1:]...)
+		fmt.Printf("🗑️  Removed field: %s\n", name)
+	}
+
+	return nil
+}
+
+// formatField describes a field as shown in the field lists and preview
+func formatField(field CRUDField) string {
+	description := fmt.Sprintf("%s: %s", field.Name, field.Type)
+	if field.Required {
+		description += " (required)"
+	}
+	if field.Unique {
+		description += " (unique)"
 	}
+	return description
+}
 
-	if confirm == "Quit" {
-		return ErrUserQuit
+// renameField renames the entity's field at index, regenerating its tags and updating the
+// relationships that use it as their key; names must stay valid and distinct
+func renameField(entity *CRUDEntity, index int, name string) error {
+	if !isValidFieldName(name) {
+		return fmt.Errorf("invalid field name: must start with letter and contain only letters/numbers")
+	}
+	if fieldNameTaken(entity.Fields, name, index) {
+		return fmt.Errorf("a field named %s already exists", name)
 	}
 
-	if confirm[:2] == "No" {
-		fmt.Println("❌ CRUD generation cancelled")
-		return ErrReturnToMenu
+	field := &entity.Fields[index]
+	for i := range entity.Relationships {
+		if entity.Relationships[i].Field == field.Name {
+			entity.Relationships[i].Field = name
+		}
 	}
 
+	field.Name = name
+	field.JSONTag = strings.ToLower(name)
+	field.DBTag = strings.ToLower(name)
 	return nil
 }
 
+// fieldNameTaken reports whether another field than skip already uses name, ignoring case
+// since the generated tags and columns are lower-cased
+func fieldNameTaken(fields []CRUDField, name string, skip int) bool {
+	for i, field := range fields {
+		if i != skip && strings.EqualFold(field.Name, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// moveField returns fields with the field at from moved to position to
+func moveField(fields []CRUDField, from, to int) []CRUDField {
+	if from == to || from < 0 || to < 0 || from >= len(fields) || to >= len(fields) {
+		return fields
+	}
+
+	field := fields[from]
+	fields = append(fields[:from], fields[from+1:]...)
+	fields = append(fields[:to], append([]CRUDField{field}, fields[to:]...)...)
+	return fields
+}
+
+// fieldTypeOption returns the type option for a Go type, for use as a prompt default
+func fieldTypeOption(fieldType string) string {
+	for _, option := range crudFieldTypes {
+		if strings.Split(option, " - ")[0] == fieldType {
+			return option
+		}
+	}
+	return crudFieldTypes[0]
+}
+
+// yesNo renders a flag for prompts
+func yesNo(value bool) string {
+	if value {
+		return "yes"
+	}
+	return "no"
+}
+
 // defineField handles individual field definition
 func defineField() (CRUDField, error) {
 	field := CRUDField{}
@@ -367,9 +692,10 @@ func defineField() (CRUDField, error) {
 	namePrompt := &survey.Input{
 		Message: "Field name (e.g., 'email', 'title', 'price'):",
 		Help:    "Use camelCase for Go conventions",
+		Default: "name",
 	}
 
-	if err := survey.AskOne(namePrompt, &field.Name); err != nil {
+	if err := askOne(namePrompt, &field.Name); err != nil {
 		return field, fmt.Errorf("field name input failed: %w", err)
 	}
 
@@ -378,23 +704,13 @@ func defineField() (CRUDField, error) {
 	}
 
 	// Field type
-	fieldTypes := []string{
-		"string - Text data",
-		"int - Integer numbers",
-		"int64 - Large integer numbers",
-		"float64 - Decimal numbers",
-		"bool - True/false values",
-		"time.Time - Date and time",
-		"[]string - Array of strings",
-	}
-
 	var selectedType string
 	typePrompt := &survey.Select{
 		Message: "Field type:",
-		Options: fieldTypes,
+		Options: crudFieldTypes,
 	}
 
-	if err := survey.AskOne(typePrompt, &selectedType); err != nil {
+	if err := askOne(typePrompt, &selectedType); err != nil {
 		return field, fmt.Errorf("field type selection failed: %w", err)
 	}
 
@@ -411,7 +727,7 @@ func defineField() (CRUDField, error) {
 		},
 	}
 
-	if err := survey.AskOne(requiredPrompt, &requiredChoice); err != nil {
+	if err := askOne(requiredPrompt, &requiredChoice); err != nil {
 		return field, fmt.Errorf("required prompt failed: %w", err)
 	}
 
@@ -431,7 +747,7 @@ func defineField() (CRUDField, error) {
 		},
 	}
 
-	if err := survey.AskOne(uniquePrompt, &uniqueChoice); err != nil {
+	if err := askOne(uniquePrompt, &uniqueChoice); err != nil {
 		return field, fmt.Errorf("unique prompt failed: %w", err)
 	}
 
@@ -483,49 +799,29 @@ func pluralize(singular string) string {
 	return singular + "s"
 }
 
-func getCommonFields(entityName string) []CRUDField {
-	switch entityName {
-	case "user":
-		return []CRUDField{
-			{Name: "Name", Type: "string", JSONTag: "name", DBTag: "name", Required: true, Description: "- User's full name"},
-			{Name: "Email", Type: "string", JSONTag: "email", DBTag: "email", Required: true, Unique: true, Description: "- User's email address"},
-			{Name: "Password", Type: "string", JSONTag: "password", DBTag: "password", Required: true, Description: "- User's password (will be hashed)"},
-			{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at", DBTag: "created_at", Description: "- Account creation timestamp"},
-			{Name: "UpdatedAt", Type: "time.Time", JSONTag: "updated_at", DBTag: "updated_at", Description: "- Last update timestamp"},
-		}
-	case "post":
-		return []CRUDField{
-			{Name: "Title", Type: "string", JSONTag: "title", DBTag: "title", Required: true, Description: "- Post title"},
-			{Name: "Content", Type: "string", JSONTag: "content", DBTag: "content", Required: true, Description: "- Post content"},
-			{Name: "AuthorID", Type: "int64", JSONTag: "author_id", DBTag: "author_id", Required: true, Description: "- Author's user ID"},
-			{Name: "Published", Type: "bool", JSONTag: "published", DBTag: "published", Description: "- Publication status"},
-			{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at", DBTag: "created_at", Description: "- Creation timestamp"},
-			{Name: "UpdatedAt", Type: "time.Time", JSONTag: "updated_at", DBTag: "updated_at", Description: "- Last update timestamp"},
-		}
-	case "product":
-		return []CRUDField{
-			{Name: "Name", Type: "string", JSONTag: "name", DBTag: "name", Required: true, Description: "- Product name"},
-			{Name: "Description", Type: "string", JSONTag: "description", DBTag: "description", Description: "- Product description"},
-			{Name: "Price", Type: "float64", JSONTag: "price", DBTag: "price", Required: true, Description: "- Product price"},
-			{Name: "SKU", Type: "string", JSONTag: "sku", DBTag: "sku", Unique: true, Description: "- Stock keeping unit"},
-			{Name: "InStock", Type: "bool", JSONTag: "in_stock", DBTag: "in_stock", Description: "- Availability status"},
-			{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at", DBTag: "created_at", Description: "- Creation timestamp"},
-			{Name: "UpdatedAt", Type: "time.Time", JSONTag: "updated_at", DBTag: "updated_at", Description: "- Last update timestamp"},
-		}
-	case "task":
-		return []CRUDField{
-			{Name: "Title", Type: "string", JSONTag: "title", DBTag: "title", Required: true, Description: "- Task title"},
-			{Name: "Description", Type: "string", JSONTag: "description", DBTag: "description", Description: "- Task description"},
-			{Name: "Status", Type: "string", JSONTag: "status", DBTag: "status", Required: true, Description: "- Task status (pending, in_progress, completed)"},
-			{Name: "Priority", Type: "string", JSONTag: "priority", DBTag: "priority", Description: "- Task priority (low, medium, high)"},
-			{Name: "DueDate", Type: "time.Time", JSONTag: "due_date", DBTag: "due_date", Description: "- Task due date"},
-			{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at", DBTag: "created_at", Description: "- Creation timestamp"},
-			{Name: "UpdatedAt", Type: "time.Time", JSONTag: "updated_at", DBTag: "updated_at", Description: "- Last update timestamp"},
-		}
-	default:
-		return []CRUDField{
-			{Name: "CreatedAt", Type: "time.Time", JSONTag: "created_at", DBTag: "created_at", Description: "- Creation timestamp"},
-			{Name: "UpdatedAt", Type: "time.Time", JSONTag: "updated_at", DBTag: "updated_at", Description: "- Last update timestamp"},
-		}
+// getCommonFields returns the preset fields for entityName, or just the
+// timestamps when the library has no preset of that name
+func getCommonFields(library *presets.Library, entityName string) []CRUDField {
+	presetFields := presets.Timestamps()
+	if preset, ok := library.Lookup(entityName); ok {
+		presetFields = preset.Fields
 	}
+
+	fields := make([]CRUDField, 0, len(presetFields))
+	for _, field := range presetFields {
+		description := ""
+		if field.Description != "" {
+			description = "- " + field.Description
+		}
+		fields = append(fields, CRUDField{
+			Name:        field.Name,
+			Type:        field.Type,
+			JSONTag:     field.Tag(),
+			DBTag:       field.Tag(),
+			Required:    field.Required,
+			Unique:      field.Unique,
+			Description: description,
+		})
+	}
+	return fields
 }
@@ -1,7 +1,14 @@
 package cmd
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/presets"
+	"github.com/buildwithhp/gophex/internal/testutil"
 )
 
 func TestIsValidEntityName(t *testing.T) {
@@ -92,12 +99,18 @@ func TestGetCommonFields(t *testing.T) {
 		{"post", 6, true, true},
 		{"product", 7, true, true},
 		{"task", 7, true, true},
+		{"order", 7, true, true},
+		{"invoice", 8, true, true},
+		{"comment", 6, true, true},
+		{"organization", 6, true, true},
+		{"address", 9, true, true},
+		{"file", 8, true, true},
 		{"unknown", 2, true, true}, // Default fields
 	}
 
 	for _, test := range tests {
 		t.Run(test.entityName, func(t *testing.T) {
-			fields := getCommonFields(test.entityName)
+			fields := getCommonFields(presets.Builtin(), test.entityName)
 
 			if len(fields) != test.expectedCount {
 				t.Errorf("getCommonFields(%q) returned %d fields, expected %d",
@@ -198,3 +211,406 @@ func TestCRUDEntityValidation(t *testing.T) {
 		t.Error("Expected Email field to be unique")
 	}
 }
+
+func TestMoveField(t *testing.T) {
+	tests := []struct {
+		from, to int
+		expected []string
+	}{
+		{0, 1, []string{"Email", "Name", "Age"}},
+		{2, 0, []string{"Age", "Name", "Email"}},
+		{1, 1, []string{"Name", "Email", "Age"}},
+		{0, 3, []string{"Name", "Email", "Age"}},
+	}
+
+	for _, test := range tests {
+		fields := []CRUDField{{Name: "Name"}, {Name: "Email"}, {Name: "Age"}}
+		fields = moveField(fields, test.from, test.to)
+
+		var names []string
+		for _, field := range fields {
+			names = append(names, field.Name)
+		}
+		if strings.Join(names, ",") != strings.Join(test.expected, ",") {
+			t.Errorf("Expected %v after moving %d to %d, got %v", test.expected, test.from, test.to, names)
+		}
+	}
+}
+
+func TestRenameField(t *testing.T) {
+	tests := []struct {
+		name      string
+		newName   string
+		expectErr bool
+	}{
+		{"valid rename", "FullName", false},
+		{"same name", "Name", false},
+		{"invalid name", "1name", true},
+		{"duplicate ignoring case", "email", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			entity := &CRUDEntity{Fields: []CRUDField{
+				{Name: "Name", JSONTag: "name", DBTag: "name"},
+				{Name: "Email", JSONTag: "email", DBTag: "email"},
+			}}
+			fields := entity.Fields
+
+			err := renameField(entity, 0, test.newName)
+			if test.expectErr {
+				if err == nil {
+					t.Errorf("Expected error renaming to %q", test.newName)
+				}
+				if fields[0].Name != "Name" {
+					t.Errorf("Expected field to keep its name on error, got %s", fields[0].Name)
+				}
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			tag := strings.ToLower(test.newName)
+			if fields[0].Name != test.newName || fields[0].JSONTag != tag || fields[0].DBTag != tag {
+				t.Errorf("Expected %s with tags %s, got %+v", test.newName, tag, fields[0])
+			}
+		})
+	}
+}
+
+func TestFormatField(t *testing.T) {
+	field := CRUDField{Name: "Email", Type: "string", Required: true, Unique: true}
+	if formatted := formatField(field); formatted != "Email: string (required) (unique)" {
+		t.Errorf("Expected 'Email: string (required) (unique)', got %q", formatted)
+	}
+	if option := fieldTypeOption("time.Time"); option != "time.Time - Date and time" {
+		t.Errorf("Expected the time.Time type option, got %q", option)
+	}
+}
+
+func TestCRUDEndpoints(t *testing.T) {
+	tests := []struct {
+		updateMethod string
+		expected     int
+	}{
+		{"put", 5},
+		{"patch", 5},
+		{"both", 6},
+	}
+
+	for _, test := range tests {
+		t.Run(test.updateMethod, func(t *testing.T) {
+			data := &CRUDTemplateData{
+				Entity: &CRUDEntity{Name: "product", PluralName: "products", UpdateMethod: test.updateMethod},
+			}
+
+			endpoints := crudEndpoints(data)
+			if len(endpoints) != test.expected {
+				t.Fatalf("Expected %d endpoints, got %d", test.expected, len(endpoints))
+			}
+
+			create := endpoints[0]
+			if create.Method != "POST" || create.Path != "/api/products" {
+				t.Errorf("Expected POST /api/products, got %s %s", create.Method, create.Path)
+			}
+			if create.RequestSchema != "CreateProductRequest" {
+				t.Errorf("Expected request schema 'CreateProductRequest', got %q", create.RequestSchema)
+			}
+			if create.ResponseSchema != "ProductResponse" {
+				t.Errorf("Expected response schema 'ProductResponse', got %q", create.ResponseSchema)
+			}
+			if len(create.Tags) != 1 || create.Tags[0] != "products" {
+				t.Errorf("Expected tags [products], got %v", create.Tags)
+			}
+		})
+	}
+}
+
+func TestCRUDMinimalDepsRouting(t *testing.T) {
+	projectPath := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(projectPath, "internal", "api", "handlers"), 0755); err != nil {
+		t.Fatalf("Failed to create handlers directory: %v", err)
+	}
+
+	data := &CRUDTemplateData{
+		Entity:       &CRUDEntity{Name: "product", PluralName: "products", UpdateMethod: "both"},
+		ModuleName:   "shop",
+		DatabaseType: "postgresql",
+		MinimalDeps:  true,
+	}
+
+	if err := generateHandlerFile(projectPath, data); err != nil {
+		t.Fatalf("Failed to generate handler: %v", err)
+	}
+	if err := createRoutesFile(projectPath, data); err != nil {
+		t.Fatalf("Failed to generate routes: %v", err)
+	}
+	if err := generateEntityRoutesFile(projectPath, data); err != nil {
+		t.Fatalf("Failed to generate entity routes: %v", err)
+	}
+
+	handler, err := os.ReadFile(filepath.Join(projectPath, "internal", "api", "handlers", "product.go"))
+	if err != nil {
+		t.Fatalf("Failed to read handler: %v", err)
+	}
+	setup, err := os.ReadFile(filepath.Join(projectPath, "internal", "api", "routes", "routes.go"))
+	if err != nil {
+		t.Fatalf("Failed to read routes: %v", err)
+	}
+	routes, err := os.ReadFile(filepath.Join(projectPath, "internal", "api", "routes", "product.go"))
+	if err != nil {
+		t.Fatalf("Failed to read entity routes: %v", err)
+	}
+
+	if !strings.Contains(string(setup), "RegisterProductRoutes(router, productService)") {
+		t.Errorf("Expected SetupRoutes to register the product routes, got:\n%s", setup)
+	}
+	for _, content := range []string{string(handler), string(setup), string(routes)} {
+		if strings.Contains(content, "gorilla/mux") {
+			t.Errorf("Expected no gorilla/mux import in minimal mode, got:\n%s", content)
+		}
+	}
+	if !strings.Contains(string(handler), `r.PathValue("id")`) {
+		t.Error("Expected handler to read the id with r.PathValue")
+	}
+	if !strings.Contains(string(routes), `router.HandleFunc("PATCH /api/products/{id}", productHandler.PatchProduct)`) {
+		t.Errorf("Expected a ServeMux method pattern for PATCH, got:\n%s", routes)
+	}
+}
+
+func TestCRUDEntityForeignKeys(t *testing.T) {
+	library := presets.Builtin()
+	preset, _ := library.Lookup("comment")
+
+	entity := &CRUDEntity{
+		Name:          "comment",
+		Fields:        getCommonFields(library, "comment"),
+		Relationships: preset.Relationships,
+	}
+
+	keys := entity.ForeignKeys()
+	if len(keys) != 2 {
+		t.Fatalf("Expected 2 foreign keys, got %d", len(keys))
+	}
+	if keys[0].DBTag != "post_id" || keys[1].DBTag != "author_id" {
+		t.Errorf("Expected foreign keys post_id and author_id, got %s and %s", keys[0].DBTag, keys[1].DBTag)
+	}
+
+	// A deleted foreign key field no longer gets an index
+	entity.Fields = entity.Fields[1:]
+	if keys := entity.ForeignKeys(); len(keys) != 1 {
+		t.Errorf("Expected 1 foreign key after deleting PostID, got %d", len(keys))
+	}
+}
+
+func TestRenameFieldUpdatesRelationships(t *testing.T) {
+	library := presets.Builtin()
+	preset, _ := library.Lookup("comment")
+
+	entity := &CRUDEntity{
+		Name:          "comment",
+		Fields:        getCommonFields(library, "comment"),
+		Relationships: append([]presets.Relationship(nil), preset.Relationships...),
+	}
+
+	index := -1
+	for i, field := range entity.Fields {
+		if field.Name == "PostID" {
+			index = i
+		}
+	}
+	if index < 0 {
+		t.Fatal("Expected the comment preset to have a PostID field")
+	}
+
+	if err := renameField(entity, index, "ArticleID"); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+
+	keys := entity.ForeignKeys()
+	if len(keys) != 2 || keys[0].DBTag != "articleid" {
+		t.Errorf("Expected the renamed foreign key to keep its index, got %+v", keys)
+	}
+	if preset, _ := library.Lookup("comment"); preset.Relationships[0].Field != "PostID" {
+		t.Errorf("Expected the preset to be unchanged, got %s", preset.Relationships[0].Field)
+	}
+}
+
+func TestZeroCheck(t *testing.T) {
+	tests := []struct {
+		expr     string
+		goType   string
+		expected string
+	}{
+		{"req.Name", "string", `req.Name == ""`},
+		{"req.Count", "int", "req.Count == 0"},
+		{"req.Price", "float64", "req.Price == 0"},
+		{"req.DueDate", "time.Time", "req.DueDate.IsZero()"},
+		{"*req.DueDate", "time.Time", "(*req.DueDate).IsZero()"},
+		{"req.Tags", "[]string", "len(req.Tags) == 0"},
+		{"req.Active", "bool", ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.goType, func(t *testing.T) {
+			if result := zeroCheck(test.expr, test.goType); result != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestGenerateIntegrationTests(t *testing.T) {
+	projectPath := t.TempDir()
+	for _, dir := range []string{"internal/domain/product", "internal/api/routes"} {
+		if err := os.MkdirAll(filepath.Join(projectPath, dir), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", dir, err)
+		}
+	}
+
+	data := &CRUDTemplateData{
+		Entity:       &CRUDEntity{Name: "product", PluralName: "products", UpdateMethod: "put", Fields: getCommonFields(presets.Builtin(), "product")},
+		ModuleName:   "shop",
+		DatabaseType: "postgresql",
+	}
+
+	if err := generateMemoryRepositoryFile(projectPath, data); err != nil {
+		t.Fatalf("Failed to generate in-memory repository: %v", err)
+	}
+	if err := generateIntegrationTestFile(projectPath, data); err != nil {
+		t.Fatalf("Failed to generate integration tests: %v", err)
+	}
+
+	repo, err := os.ReadFile(filepath.Join(projectPath, "internal", "domain", "product", "memory_repository.go"))
+	if err != nil {
+		t.Fatalf("Failed to read in-memory repository: %v", err)
+	}
+	if !strings.Contains(string(repo), "func NewMemoryRepository(ids idgen.Generator) Repository") {
+		t.Error("Expected a NewMemoryRepository constructor taking an ID generator")
+	}
+	if strings.Contains(string(repo), "func (r *memoryRepository) Patch") {
+		t.Error("Expected no Patch method for a PUT-only entity")
+	}
+
+	tests, err := os.ReadFile(filepath.Join(projectPath, "internal", "api", "routes", "product_test.go"))
+	if err != nil {
+		t.Fatalf("Failed to read integration tests: %v", err)
+	}
+	for _, want := range []string{
+		"product.NewService(product.NewMemoryRepository(idgen.NewSequence()), fakeClock)",
+		`if id != "1" {`,
+		`if got := productField(t, envelope, "updated_at"); got != productEpoch.Add(time.Hour).Format(time.RFC3339) {`,
+		`"price": float64(n) + 0.5,`,
+		`{"create without name", http.MethodPost`,
+		`{"replace missing", http.MethodPut, missing`,
+		"func TestProductPagination(t *testing.T)",
+	} {
+		if !strings.Contains(string(tests), want) {
+			t.Errorf("Expected integration tests to contain %q", want)
+		}
+	}
+	if strings.Contains(string(tests), "http.MethodPatch") {
+		t.Error("Expected no PATCH requests for a PUT-only entity")
+	}
+}
+
+func TestGenerateSupportPackages(t *testing.T) {
+	projectPath := t.TempDir()
+	data := &CRUDTemplateData{ModuleName: "shop", DatabaseType: "mongodb"}
+
+	if err := generateSupportPackages(projectPath, data); err != nil {
+		t.Fatalf("Failed to generate support packages: %v", err)
+	}
+
+	idgenPath := filepath.Join(projectPath, "internal", "pkg", "idgen", "idgen.go")
+	ids, err := os.ReadFile(idgenPath)
+	if err != nil {
+		t.Fatalf("Failed to read idgen package: %v", err)
+	}
+	if !strings.Contains(string(ids), "NewID() primitive.ObjectID") {
+		t.Error("Expected ObjectID generator for MongoDB")
+	}
+	if _, err := os.Stat(filepath.Join(projectPath, "internal", "pkg", "clock", "clock.go")); err != nil {
+		t.Errorf("Expected clock package: %v", err)
+	}
+
+	// Packages shared by earlier entities are left untouched
+	if err := os.WriteFile(idgenPath, []byte("package idgen // customized\n"), 0644); err != nil {
+		t.Fatalf("Failed to customize idgen package: %v", err)
+	}
+	if err := generateSupportPackages(projectPath, data); err != nil {
+		t.Fatalf("Failed to regenerate support packages: %v", err)
+	}
+	ids, _ = os.ReadFile(idgenPath)
+	if !strings.Contains(string(ids), "customized") {
+		t.Error("Expected existing idgen package to be kept")
+	}
+}
+
+func TestGenerateCRUDCodeLayers(t *testing.T) {
+	projectPath := testutil.WriteProject(t, &metadata.ProjectMetadata{
+		Project:  metadata.ProjectInfo{Name: "shop", Type: "api"},
+		Features: map[string]bool{},
+	}, map[string]string{"go.mod": "module shop\n\ngo 1.22\n"})
+
+	newProduct := func(layers ...string) *CRUDEntity {
+		return &CRUDEntity{Name: "product", PluralName: "products", UpdateMethod: "put", Fields: getCommonFields(presets.Builtin(), "product"), Layers: layers}
+	}
+	exists := func(path string) bool {
+		_, err := os.Stat(filepath.Join(projectPath, path))
+		return err == nil
+	}
+	recorded := func() metadata.EntityInfo {
+		projectMetadata, err := metadata.LoadMetadata(projectPath)
+		if err != nil {
+			t.Fatalf("Failed to load metadata: %v", err)
+		}
+		entity, ok := metadata.FindEntity(projectMetadata, "product")
+		if !ok {
+			t.Fatal("Expected product to be recorded in gophex.md")
+		}
+		return entity
+	}
+
+	if err := generateCRUDCode(projectPath, newProduct(metadata.LayerHTTP)); err == nil {
+		t.Error("Expected an error when generating HTTP without the domain layer")
+	}
+
+	if err := generateCRUDCode(projectPath, newProduct(metadata.LayerDomain, metadata.LayerRepository)); err != nil {
+		t.Fatalf("Failed to generate domain and repository layers: %v", err)
+	}
+	for _, path := range []string{"internal/domain/product/repository.go", "internal/domain/product/sql_repository.go", "internal/pkg/clock/clock.go"} {
+		if !exists(path) {
+			t.Errorf("Expected %s to be generated", path)
+		}
+	}
+	for _, path := range []string{"internal/api/handlers/product.go", "internal/api/routes/product_test.go"} {
+		if exists(path) {
+			t.Errorf("Expected %s to be skipped without the http layer", path)
+		}
+	}
+	if missing := recorded().MissingLayers(); len(missing) != 1 || missing[0] != metadata.LayerHTTP {
+		t.Errorf("Expected the http layer to be recorded as missing, got %v", missing)
+	}
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to load metadata: %v", err)
+	}
+	if record := projectMetadata.Files["internal/domain/product/model.go"]; record.Template != "crud/product" || record.Checksum == "" {
+		t.Errorf("Expected model.go in the file manifest attributed to crud/product, got %+v", record)
+	}
+
+	// A later run fills in the missing layer, including the tests that need every layer
+	if err := generateCRUDCode(projectPath, newProduct(metadata.LayerHTTP)); err != nil {
+		t.Fatalf("Failed to generate the http layer: %v", err)
+	}
+	for _, path := range []string{"internal/api/handlers/product.go", "internal/api/routes/product_test.go"} {
+		if !exists(path) {
+			t.Errorf("Expected %s to be generated", path)
+		}
+	}
+	if layers := recorded().Layers; len(layers) != 0 {
+		t.Errorf("Expected a complete entity to record every layer, got %v", layers)
+	}
+}
@@ -0,0 +1,267 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/registry"
+	"github.com/buildwithhp/gophex/pkg/version"
+)
+
+// dashboardUsage describes the dashboard subcommand
+const dashboardUsage = "gophex dashboard [--scan DIR] [--format table|json]"
+
+// ProjectStatus is the aggregated status of one registered project
+type ProjectStatus struct {
+	Name              string   `json:"name"`
+	Type              string   `json:"type"`
+	Path              string   `json:"path"`
+	Missing           bool     `json:"missing"`
+	Running           bool     `json:"running"`
+	PID               int      `json:"pid,omitempty"`
+	PendingMigrations int      `json:"pending_migrations"`
+	ModifiedFiles     []string `json:"modified_files,omitempty"`
+	MissingFiles      []string `json:"missing_files,omitempty"`
+	DriftTracked      bool     `json:"drift_tracked"`
+	GophexVersion     string   `json:"gophex_version,omitempty"`
+	Outdated          bool     `json:"outdated"`
+	Error             string   `json:"error,omitempty"`
+}
+
+// runDashboard implements "gophex dashboard"
+func runDashboard(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("dashboard", flag.ContinueOnError)
+	format := fs.String("format", "table", "output format: table or json")
+	scanDir := fs.String("scan", "", "discover and register gophex projects under this directory")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return usageError(dashboardUsage)
+	}
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+
+	if *scanDir != "" {
+		found, err := discoverProjects(*scanDir)
+		if err != nil {
+			return err
+		}
+		for _, project := range found {
+			if err := registry.Register(project.Name, project.Type, project.Path); err != nil {
+				return err
+			}
+		}
+	}
+
+	projects, err := registry.List()
+	if err != nil {
+		return err
+	}
+
+	statuses := make([]ProjectStatus, 0, len(projects))
+	for _, project := range projects {
+		statuses = append(statuses, collectProjectStatus(project))
+	}
+
+	if strings.ToLower(*format) == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(statuses)
+	}
+
+	if len(statuses) == 0 {
+		fmt.Fprintln(out, "No registered projects yet.")
+		fmt.Fprintln(out, "💡 Projects are registered when generated or loaded, or run: gophex dashboard --scan .")
+		return nil
+	}
+
+	return writeDashboardTable(out, statuses)
+}
+
+// discoverProjects finds directories containing gophex.md below root
+func discoverProjects(root string) ([]registry.Project, error) {
+	var projects []registry.Project
+
+	err := filepath.Walk(root, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if info.IsDir() {
+			name := info.Name()
+			if path != root && (strings.HasPrefix(name, ".") || name == "vendor" || name == "node_modules") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if info.Name() != "gophex.md" {
+			return nil
+		}
+
+		projectPath := filepath.Dir(path)
+		projectMetadata, err := metadata.LoadMetadata(projectPath)
+		if err != nil {
+			return nil
+		}
+
+		projects = append(projects, registry.Project{
+			Name: projectMetadata.Project.Name,
+			Type: projectMetadata.Project.Type,
+			Path: projectPath,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan %s: %w", root, err)
+	}
+
+	return projects, nil
+}
+
+// collectProjectStatus gathers process, migration, drift and scaffold status for a project
+func collectProjectStatus(project registry.Project) ProjectStatus {
+	status := ProjectStatus{
+		Name: project.Name,
+		Type: project.Type,
+		Path: project.Path,
+	}
+
+	if _, err := os.Stat(project.Path); err != nil {
+		status.Missing = true
+		return status
+	}
+
+	if processAlive(project.PID) {
+		status.Running = true
+		status.PID = project.PID
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(project.Path)
+	if err != nil {
+		status.Error = err.Error()
+		return status
+	}
+
+	status.PendingMigrations = countPendingMigrations(project.Path, projectMetadata)
+
+	if len(projectMetadata.Files) > 0 {
+		modified, missing, err := metadata.DetectDrift(project.Path, projectMetadata.Files)
+		if err != nil {
+			status.Error = err.Error()
+		}
+		status.DriftTracked = true
+		status.ModifiedFiles = modified
+		status.MissingFiles = missing
+	}
+
+	status.GophexVersion = projectMetadata.Project.GophexVersion
+	status.Outdated = status.GophexVersion != "" && compareVersions(status.GophexVersion, version.GetVersion()) < 0
+
+	return status
+}
+
+// countPendingMigrations counts migration files that have not been applied since the last migration run
+func countPendingMigrations(projectPath string, projectMetadata *metadata.ProjectMetadata) int {
+	migrations, _ := filepath.Glob(filepath.Join(projectPath, "migrations", "*.up.sql"))
+	if len(migrations) == 0 {
+		migrations, _ = filepath.Glob(filepath.Join(projectPath, "migrations", "*.js"))
+	}
+
+	activity := projectMetadata.Activities["database_migrated"]
+	if !activity.Completed && !projectMetadata.Database.MigrationsExecuted {
+		return len(migrations)
+	}
+
+	lastRun, err := time.Parse(time.RFC3339, activity.Timestamp)
+	if err != nil {
+		return 0
+	}
+
+	pending := 0
+	for _, migration := range migrations {
+		if info, err := os.Stat(migration); err == nil && info.ModTime().After(lastRun) {
+			pending++
+		}
+	}
+	return pending
+}
+
+// compareVersions compares dotted numeric versions, returning -1, 0 or 1
+func compareVersions(a, b string) int {
+	aParts := strings.Split(strings.TrimPrefix(a, "v"), ".")
+	bParts := strings.Split(strings.TrimPrefix(b, "v"), ".")
+
+	for i := 0; i < len(aParts) || i < len(bParts); i++ {
+		var aNum, bNum int
+		if i < len(aParts) {
+			aNum, _ = strconv.Atoi(aParts[i])
+		}
+		if i < len(bParts) {
+			bNum, _ = strconv.Atoi(bParts[i])
+		}
+		if aNum < bNum {
+			return -1
+		}
+		if aNum > bNum {
+			return 1
+		}
+	}
+	return 0
+}
+
+// writeDashboardTable renders project statuses as an aligned table
+func writeDashboardTable(out io.Writer, statuses []ProjectStatus) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "PROJECT\tTYPE\tPROCESS\tMIGRATIONS\tDRIFT\tSCAFFOLD\tPATH")
+
+	for _, s := range statuses {
+		process := "stopped"
+		switch {
+		case s.Missing:
+			process = "missing"
+		case s.Running:
+			process = fmt.Sprintf("running (PID %d)", s.PID)
+		}
+
+		migrations := "-"
+		if s.PendingMigrations > 0 {
+			migrations = fmt.Sprintf("%d pending", s.PendingMigrations)
+		} else if !s.Missing {
+			migrations = "up to date"
+		}
+
+		drift := "untracked"
+		if s.DriftTracked {
+			drift = "clean"
+			if len(s.ModifiedFiles) > 0 || len(s.MissingFiles) > 0 {
+				drift = fmt.Sprintf("%d modified, %d missing", len(s.ModifiedFiles), len(s.MissingFiles))
+			}
+		}
+
+		scaffold := orDash(s.GophexVersion)
+		if s.Outdated {
+			scaffold = fmt.Sprintf("outdated (%s → %s)", s.GophexVersion, version.GetVersion())
+		}
+		if s.Error != "" {
+			scaffold = "error: " + s.Error
+		}
+
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.Name, orDash(s.Type), process, migrations, drift, scaffold, s.Path)
+	}
+
+	return w.Flush()
+}
@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/generator"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"1.0.0", "1.0.0", 0},
+		{"0.9.0", "1.0.0", -1},
+		{"1.10.0", "1.9.0", 1},
+		{"v1.2", "1.2.0", 0},
+	}
+
+	for _, test := range tests {
+		if result := compareVersions(test.a, test.b); result != test.expected {
+			t.Errorf("compareVersions(%q, %q): expected %d, got %d", test.a, test.b, test.expected, result)
+		}
+	}
+}
+
+func TestRunDashboard(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	workspace := t.TempDir()
+	projectPath := filepath.Join(workspace, "services", "orders")
+
+	dbConfig := &generator.DatabaseConfig{Type: "postgresql", ConfigType: "single"}
+	if err := generator.New().GenerateWithConfig("api", "orders", projectPath, dbConfig); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	// Modify a generated file to introduce drift
+	mainFile := filepath.Join(projectPath, "cmd", "api", "main.go")
+	if err := os.WriteFile(mainFile, []byte("package main\n"), 0644); err != nil {
+		t.Fatalf("Failed to modify main.go: %v", err)
+	}
+
+	var out bytes.Buffer
+	if err := runDashboard([]string{"--scan", workspace, "--format", "json"}, &out); err != nil {
+		t.Fatalf("Dashboard failed: %v", err)
+	}
+
+	var statuses []ProjectStatus
+	if err := json.Unmarshal(out.Bytes(), &statuses); err != nil {
+		t.Fatalf("Expected valid JSON, got error: %v", err)
+	}
+	if len(statuses) != 1 {
+		t.Fatalf("Expected 1 project, got %d", len(statuses))
+	}
+
+	status := statuses[0]
+	if status.Name != "orders" {
+		t.Errorf("Expected project 'orders', got %q", status.Name)
+	}
+	if status.PendingMigrations != 2 {
+		t.Errorf("Expected 2 pending migrations, got %d", status.PendingMigrations)
+	}
+	if len(status.ModifiedFiles) != 1 || status.ModifiedFiles[0] != "cmd/api/main.go" {
+		t.Errorf("Expected cmd/api/main.go to be reported as modified, got %v", status.ModifiedFiles)
+	}
+	if status.Running {
+		t.Error("Project should not be reported as running")
+	}
+}
@@ -0,0 +1,189 @@
+package cmd
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/AlecAivazis/survey/v2/core"
+	"github.com/AlecAivazis/survey/v2/terminal"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+const (
+	// defaultsFlag answers every prompt with its default after showing it for a moment;
+	// --defaults=DELAY sets how long each prompt stays on screen
+	defaultsFlag = "--defaults"
+	// yesFlag answers every prompt with its default immediately
+	yesFlag = "--yes"
+)
+
+// defaultAnswerDelay is how long --defaults shows a prompt before answering it
+const defaultAnswerDelay = 2 * time.Second
+
+// maxDefaultRepeats bounds how often the same prompt is answered automatically. Menus that
+// return to themselves would otherwise loop forever, so the run ends as if interrupted.
+const maxDefaultRepeats = 3
+
+// autoAnswer answers prompts with their defaults when --defaults or --yes is given; nil otherwise
+var autoAnswer *defaultAnswerer
+
+// defaultAnswerer shows each prompt with its default answer, waits delay, and picks the default
+type defaultAnswerer struct {
+	out   io.Writer
+	delay time.Duration
+	sleep func(time.Duration)
+	asked map[string]int
+}
+
+// newDefaultAnswerer creates a default answerer that waits delay before each answer
+func newDefaultAnswerer(out io.Writer, delay time.Duration) *defaultAnswerer {
+	return &defaultAnswerer{out: out, delay: delay, sleep: time.Sleep, asked: make(map[string]int)}
+}
+
+// parseDefaultsFlag recognises --yes, -y, --defaults and --defaults=DELAY
+func parseDefaultsFlag(arg string) (bool, error) {
+	switch {
+	case arg == yesFlag || arg == "-y":
+		autoAnswer = newDefaultAnswerer(os.Stdout, 0)
+	case arg == defaultsFlag:
+		autoAnswer = newDefaultAnswerer(os.Stdout, defaultAnswerDelay)
+	case strings.HasPrefix(arg, defaultsFlag+"="):
+		delay, err := time.ParseDuration(strings.TrimPrefix(arg, defaultsFlag+"="))
+		if err != nil || delay < 0 {
+			return false, failure.User(fmt.Errorf("invalid %s delay %q", defaultsFlag, strings.TrimPrefix(arg, defaultsFlag+"=")), "use a duration such as 2s or 500ms")
+		}
+		autoAnswer = newDefaultAnswerer(os.Stdout, delay)
+	default:
+		return false, nil
+	}
+	return true, nil
+}
+
+// ask shows the prompt and its default, then writes the default into response. Prompts
+// without a default that passes their validators fail, since nobody is there to answer them.
+func (d *defaultAnswerer) ask(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
+	var options survey.AskOptions
+	for _, opt := range opts {
+		if err := opt(&options); err != nil {
+			return err
+		}
+	}
+
+	message, answer, display, err := d.defaultAnswer(prompt)
+	if err != nil {
+		return err
+	}
+
+	d.asked[message]++
+	if d.asked[message] > maxDefaultRepeats {
+		fmt.Fprintf(d.out, "\n⏹️  %q was answered %d times with its default; stopping.\n", message, maxDefaultRepeats)
+		return terminal.InterruptErr
+	}
+
+	if err := validateAnswer(answer, options.Validators); err != nil {
+		if _, isPassword := prompt.(*survey.Password); !isPassword {
+			return failure.User(fmt.Errorf("no valid default for %q: %v", message, err), "answer this prompt interactively, without --defaults or --yes")
+		}
+		// Required passwords, such as the development database's, get a random one
+		if answer, err = generatePassword(); err != nil {
+			return err
+		}
+		display = "(generated)"
+	}
+
+	if d.delay > 0 {
+		fmt.Fprintf(d.out, "   ⏳ Using the default in %s...\n", d.delay)
+		d.sleep(d.delay)
+	}
+	fmt.Fprintf(d.out, "   ⏩ %s\n", display)
+
+	return core.WriteAnswer(response, "", answer)
+}
+
+// defaultAnswer prints the prompt and returns its message, default answer in survey's
+// answer type, and the answer as it is displayed
+func (d *defaultAnswerer) defaultAnswer(prompt survey.Prompt) (string, interface{}, string, error) {
+	switch q := prompt.(type) {
+	case *survey.Select:
+		if len(q.Options) == 0 {
+			return q.Message, nil, "", fmt.Errorf("%q has no options", q.Message)
+		}
+		// Like survey, the cursor starts on the first option when there is no default
+		index := optionIndex(q.Options, q.Default)
+		if index < 0 {
+			index = 0
+		}
+		d.printQuestion(q.Message, "")
+		d.printOptions(q.Options, map[int]bool{index: true})
+		return q.Message, core.OptionAnswer{Value: q.Options[index], Index: index}, q.Options[index], nil
+	case *survey.MultiSelect:
+		indexes := defaultOptionIndexes(q.Options, q.Default)
+		selected := make(map[int]bool)
+		answers := []core.OptionAnswer{}
+		values := []string{}
+		for _, index := range indexes {
+			selected[index] = true
+			answers = append(answers, core.OptionAnswer{Value: q.Options[index], Index: index})
+			values = append(values, q.Options[index])
+		}
+		d.printQuestion(q.Message, "")
+		d.printOptions(q.Options, selected)
+		if len(values) == 0 {
+			return q.Message, answers, "(none selected)", nil
+		}
+		return q.Message, answers, strings.Join(values, ", "), nil
+	case *survey.Confirm:
+		hint, display := "y/N", "No"
+		if q.Default {
+			hint, display = "Y/n", "Yes"
+		}
+		d.printQuestion(q.Message, hint)
+		return q.Message, q.Default, display, nil
+	case *survey.Input:
+		d.printQuestion(q.Message, q.Default)
+		if q.Default == "" {
+			return q.Message, q.Default, "(empty)", nil
+		}
+		return q.Message, q.Default, q.Default, nil
+	case *survey.Password:
+		d.printQuestion(q.Message, "")
+		return q.Message, "", "(empty)", nil
+	default:
+		return "", nil, "", fmt.Errorf("%T prompts have no default answer", prompt)
+	}
+}
+
+// printQuestion prints a prompt the way survey shows it, with an optional hint in parentheses
+func (d *defaultAnswerer) printQuestion(message, hint string) {
+	if hint != "" {
+		fmt.Fprintf(d.out, "? %s (%s)\n", strings.TrimSpace(message), hint)
+		return
+	}
+	fmt.Fprintf(d.out, "? %s\n", strings.TrimSpace(message))
+}
+
+// printOptions lists options, marking the ones the default selects
+func (d *defaultAnswerer) printOptions(options []string, selected map[int]bool) {
+	for i, option := range options {
+		marker := " "
+		if selected[i] {
+			marker = "❯"
+		}
+		fmt.Fprintf(d.out, "  %s %s\n", marker, option)
+	}
+}
+
+// generatePassword returns a random password for prompts that require one
+func generatePassword() (string, error) {
+	secret := make([]byte, 16)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to generate a password: %w", err)
+	}
+	return hex.EncodeToString(secret), nil
+}
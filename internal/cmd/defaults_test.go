@@ -0,0 +1,174 @@
+package cmd
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+func TestDefaultAnswererAnswers(t *testing.T) {
+	var out bytes.Buffer
+	d := newDefaultAnswerer(&out, 0)
+
+	var choice string
+	if err := d.ask(&survey.Select{Message: "Database", Options: []string{"postgresql", "mysql", "mongodb"}, Default: "mysql"}, &choice); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if choice != "mysql" {
+		t.Errorf("Expected mysql, got %q", choice)
+	}
+	if !strings.Contains(out.String(), "  ❯ mysql\n") {
+		t.Errorf("Expected the default option to be marked, got %q", out.String())
+	}
+
+	var first string
+	if err := d.ask(&survey.Select{Message: "Type", Options: []string{"api", "webapp"}}, &first); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if first != "api" {
+		t.Errorf("Expected the first option without a default, got %q", first)
+	}
+
+	var layers []string
+	if err := d.ask(&survey.MultiSelect{Message: "Layers", Options: []string{"domain", "repository", "http"}, Default: []string{"domain", "http"}}, &layers); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(layers, []string{"domain", "http"}) {
+		t.Errorf("Expected [domain http], got %v", layers)
+	}
+
+	confirmed := false
+	if err := d.ask(&survey.Confirm{Message: "Continue?", Default: true}, &confirmed); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if !confirmed {
+		t.Error("Expected the default yes")
+	}
+
+	var port string
+	if err := d.ask(&survey.Input{Message: "Port", Default: "8080"}, &port); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if port != "8080" {
+		t.Errorf("Expected 8080, got %q", port)
+	}
+	if !strings.Contains(out.String(), "? Port (8080)\n   ⏩ 8080\n") {
+		t.Errorf("Expected the prompt and its answer to be shown, got %q", out.String())
+	}
+}
+
+func TestDefaultAnswererRequiredWithoutDefault(t *testing.T) {
+	d := newDefaultAnswerer(io.Discard, 0)
+
+	var name string
+	err := d.ask(&survey.Input{Message: "Project name"}, &name, survey.WithValidator(survey.Required))
+	if err == nil || !strings.Contains(err.Error(), `no valid default for "Project name"`) {
+		t.Errorf("Expected a missing default error, got %v", err)
+	}
+	if failure.KindOf(err) != failure.KindUser {
+		t.Errorf("Expected a user error, got %s", failure.KindOf(err))
+	}
+}
+
+func TestDefaultAnswererDelay(t *testing.T) {
+	var out bytes.Buffer
+	d := newDefaultAnswerer(&out, 2*time.Second)
+
+	var slept time.Duration
+	d.sleep = func(delay time.Duration) { slept += delay }
+
+	var answer bool
+	if err := d.ask(&survey.Confirm{Message: "Continue?"}, &answer); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if slept != 2*time.Second {
+		t.Errorf("Expected to wait 2s, waited %s", slept)
+	}
+	if !strings.Contains(out.String(), "Using the default in 2s") {
+		t.Errorf("Expected the countdown to be shown, got %q", out.String())
+	}
+}
+
+func TestDefaultAnswererStopsLoops(t *testing.T) {
+	d := newDefaultAnswerer(io.Discard, 0)
+	prompt := &survey.Select{Message: "What would you like to do next?", Options: []string{"Return to menu", "Exit Gophex"}}
+
+	var choice string
+	for i := 0; i < maxDefaultRepeats; i++ {
+		if err := d.ask(prompt, &choice); err != nil {
+			t.Fatalf("Unexpected error on answer %d: %v", i+1, err)
+		}
+	}
+	if err := d.ask(prompt, &choice); !isUserInterrupt(err) {
+		t.Errorf("Expected an interrupt once the prompt repeats, got %v", err)
+	}
+}
+
+func TestParseGlobalFlagsDefaults(t *testing.T) {
+	defer func(answerer *defaultAnswerer) { autoAnswer = answerer }(autoAnswer)
+
+	tests := []struct {
+		args     []string
+		expected time.Duration
+	}{
+		{[]string{"--defaults"}, defaultAnswerDelay},
+		{[]string{"--defaults=500ms"}, 500 * time.Millisecond},
+		{[]string{"--yes"}, 0},
+		{[]string{"-y"}, 0},
+	}
+
+	for _, tt := range tests {
+		autoAnswer = nil
+		remaining, err := ParseGlobalFlags(tt.args)
+		if err != nil {
+			t.Fatalf("Unexpected error for %v: %v", tt.args, err)
+		}
+		if len(remaining) != 0 || autoAnswer == nil {
+			t.Fatalf("Expected %v to enable default answers, got %v", tt.args, remaining)
+		}
+		if autoAnswer.delay != tt.expected {
+			t.Errorf("Expected delay %s for %v, got %s", tt.expected, tt.args, autoAnswer.delay)
+		}
+	}
+
+	if _, err := ParseGlobalFlags([]string{"--defaults=soon"}); err == nil {
+		t.Error("Expected error for an invalid delay")
+	}
+}
+
+func TestProjectWizardWithDefaults(t *testing.T) {
+	defer func(answerer *defaultAnswerer) { autoAnswer = answerer }(autoAnswer)
+	autoAnswer = newDefaultAnswerer(io.Discard, 0)
+
+	t.Setenv("GOPHEX_HOME", t.TempDir())
+	workDir := t.TempDir()
+	t.Chdir(workDir)
+
+	if err := RunEnhancedProjectWizard(); err != nil {
+		t.Fatalf("Expected the wizard to finish with defaults, got %v", err)
+	}
+
+	projectPath := filepath.Join(workDir, defaultProjectName("api"))
+	for _, file := range []string{"go.mod", "gophex.md", filepath.Join("cmd", "api", "main.go")} {
+		if _, err := os.Stat(filepath.Join(projectPath, file)); err != nil {
+			t.Errorf("Expected %s to be generated: %v", file, err)
+		}
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to load metadata: %v", err)
+	}
+	if projectMetadata.Project.Name != "my-api" || projectMetadata.Project.Type != "api" {
+		t.Errorf("Expected the default API project, got %+v", projectMetadata.Project)
+	}
+}
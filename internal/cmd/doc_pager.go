@@ -0,0 +1,363 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/term"
+)
+
+// defaultPageHeight is used when the terminal height cannot be determined
+const defaultPageHeight = 24
+
+// ansiBold is the style used for headings in the documentation pager
+const ansiBold = "\033[1m"
+
+// docHeading is a Markdown heading and the line it starts on
+type docHeading struct {
+	Level int
+	Text  string
+	Line  int
+}
+
+// docPager shows a document a page at a time. Commands are typed and confirmed with
+// Enter, which works in every terminal and with screen readers. View documentation and
+// the wizards' code previews both page through it.
+type docPager struct {
+	in       *bufio.Reader
+	out      io.Writer
+	height   int
+	color    bool
+	openable bool // whether o offers another document
+	title    string
+	lines    []string // as written, searched
+	rendered []string // highlighted, shown
+	headings []docHeading
+	top      int
+	query    string
+}
+
+// newDocPager creates a pager showing height lines per screen, including the status line
+func newDocPager(in io.Reader, out io.Writer, height int, color bool) *docPager {
+	if height < 5 {
+		height = defaultPageHeight
+	}
+	return &docPager{in: bufio.NewReader(in), out: out, height: height, color: color}
+}
+
+// load replaces the pager's document
+func (p *docPager) load(title, content string) {
+	content = strings.TrimRight(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	p.title = title
+	p.lines = strings.Split(content, "\n")
+	p.rendered = strings.Split(renderCodeBlocks(content, p.color), "\n")
+	p.headings = parseHeadings(p.lines)
+	p.top = 0
+	p.query = ""
+	if p.color {
+		for _, heading := range p.headings {
+			p.rendered[heading.Line] = ansiBold + ansiCyan + p.lines[heading.Line] + ansiReset
+		}
+	}
+}
+
+// pageSize is the number of document lines shown per screen
+func (p *docPager) pageSize() int {
+	return p.height - 2
+}
+
+// run shows pages and handles commands until the user quits or asks to open another
+// document. It returns "quit" or "open".
+func (p *docPager) run() (string, error) {
+	p.showPage()
+	for {
+		fmt.Fprint(p.out, ": ")
+		line, err := p.in.ReadString('\n')
+		if err != nil && (err != io.EOF || line == "") {
+			if err == io.EOF {
+				return "quit", nil
+			}
+			return "", err
+		}
+
+		if action := p.handle(strings.TrimSpace(line)); action != "" {
+			return action, nil
+		}
+	}
+}
+
+// handle runs one pager command, returning "quit" or "open" when the pager should close
+func (p *docPager) handle(command string) string {
+	last := p.lastTop()
+
+	switch {
+	case command == "" || command == "f" || command == " ":
+		if p.top >= last {
+			fmt.Fprintln(p.out, "(end of document; b goes back, q quits)")
+			return ""
+		}
+		p.top = min(p.top+p.pageSize(), last)
+	case command == "b":
+		p.top = max(p.top-p.pageSize(), 0)
+	case command == "g":
+		p.top = 0
+	case command == "G":
+		p.top = last
+	case command == "q" || command == "Q":
+		return "quit"
+	case command == "o" && p.openable:
+		return "open"
+	case command == "h":
+		p.listHeadings()
+		return ""
+	case command == "]" || command == "[":
+		index := p.adjacentHeading(command == "]")
+		if index < 0 {
+			fmt.Fprintln(p.out, "(no more headings)")
+			return ""
+		}
+		p.top = p.headings[index].Line
+	case strings.HasPrefix(command, "/"):
+		p.query = strings.TrimSpace(command[1:])
+		if p.query == "" {
+			fmt.Fprintln(p.out, "(type /text to search)")
+			return ""
+		}
+		if !p.search(p.top, true) {
+			return ""
+		}
+	case command == "n" || command == "N":
+		if p.query == "" {
+			fmt.Fprintln(p.out, "(no search yet; type /text to search)")
+			return ""
+		}
+		if !p.search(p.top, command == "n") {
+			return ""
+		}
+	case command == "?":
+		p.printHelp()
+		return ""
+	default:
+		number, err := strconv.Atoi(command)
+		if err != nil || number < 1 || number > len(p.headings) {
+			fmt.Fprintf(p.out, "Unknown command %q. Type ? for help.\n", command)
+			return ""
+		}
+		p.top = p.headings[number-1].Line
+	}
+
+	p.showPage()
+	return ""
+}
+
+// lastTop is the first line of the last full page
+func (p *docPager) lastTop() int {
+	return max(len(p.lines)-p.pageSize(), 0)
+}
+
+// showPage prints the lines from top and a status line
+func (p *docPager) showPage() {
+	end := min(p.top+p.pageSize(), len(p.lines))
+	for _, line := range p.rendered[p.top:end] {
+		fmt.Fprintln(p.out, line)
+	}
+
+	percent := 100
+	if len(p.lines) > 0 {
+		percent = end * 100 / len(p.lines)
+	}
+	open := ""
+	if p.openable {
+		open = " · o open"
+	}
+	status := fmt.Sprintf("── %s · lines %d-%d of %d (%d%%) · Enter next · b back · /search · h headings%s · q quit · ? help",
+		p.title, p.top+1, end, len(p.lines), percent, open)
+	if p.color {
+		status = ansiDim + status + ansiReset
+	}
+	fmt.Fprintln(p.out, status)
+}
+
+// search moves to the next (or previous) line after from that contains the query,
+// wrapping around the document. It reports whether a match was found.
+func (p *docPager) search(from int, forward bool) bool {
+	query := strings.ToLower(p.query)
+	count := len(p.lines)
+	for step := 1; step <= count; step++ {
+		index := (from + step) % count
+		if !forward {
+			index = ((from-step)%count + count) % count
+		}
+		if strings.Contains(strings.ToLower(p.lines[index]), query) {
+			p.top = index
+			return true
+		}
+	}
+	fmt.Fprintf(p.out, "Pattern not found: %s\n", p.query)
+	return false
+}
+
+// adjacentHeading returns the index of the next or previous heading relative to the top line, or -1
+func (p *docPager) adjacentHeading(next bool) int {
+	if next {
+		for i, heading := range p.headings {
+			if heading.Line > p.top {
+				return i
+			}
+		}
+		return -1
+	}
+	for i := len(p.headings) - 1; i >= 0; i-- {
+		if p.headings[i].Line < p.top {
+			return i
+		}
+	}
+	return -1
+}
+
+// listHeadings prints the document outline with numbers to jump to
+func (p *docPager) listHeadings() {
+	if len(p.headings) == 0 {
+		fmt.Fprintln(p.out, "(this document has no headings)")
+		return
+	}
+	fmt.Fprintln(p.out, "Headings (type a number to jump):")
+	for i, heading := range p.headings {
+		fmt.Fprintf(p.out, "%4d. %s%s\n", i+1, strings.Repeat("  ", heading.Level-1), heading.Text)
+	}
+}
+
+// printHelp lists the pager commands
+func (p *docPager) printHelp() {
+	fmt.Fprintln(p.out, "Commands (type, then press Enter):")
+	fmt.Fprintln(p.out, "  Enter, f   next page            b   previous page")
+	fmt.Fprintln(p.out, "  g          top                  G   bottom")
+	fmt.Fprintln(p.out, "  /text      search               n/N next/previous match")
+	fmt.Fprintln(p.out, "  h          list headings        3   jump to heading number 3")
+	fmt.Fprintln(p.out, "  ]          next heading         [   previous heading")
+	if p.openable {
+		fmt.Fprintln(p.out, "  o          open another doc     q   quit")
+	} else {
+		fmt.Fprintln(p.out, "  q          quit")
+	}
+}
+
+// parseHeadings finds the Markdown headings outside fenced code blocks
+func parseHeadings(lines []string) []docHeading {
+	var headings []docHeading
+	inCode := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") {
+			inCode = !inCode
+			continue
+		}
+		if inCode || !strings.HasPrefix(line, "#") {
+			continue
+		}
+		level := len(line) - len(strings.TrimLeft(line, "#"))
+		text := strings.TrimSpace(line[level:])
+		if level > 6 || text == "" || line[level] != ' ' {
+			continue
+		}
+		headings = append(headings, docHeading{Level: level, Text: text, Line: i})
+	}
+	return headings
+}
+
+// findProjectDocs lists a project's Markdown documentation relative to the project: README.md
+// first, then the README_<entity>.md files written by the CRUD generator, then docs/
+func findProjectDocs(projectPath string) ([]string, error) {
+	var readmes, docs []string
+
+	entries, err := os.ReadDir(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read project directory: %w", err)
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if !entry.IsDir() && strings.HasPrefix(name, "README") && strings.HasSuffix(name, ".md") && name != "README.md" {
+			readmes = append(readmes, name)
+		}
+	}
+	sort.Strings(readmes)
+	if fileExists(filepath.Join(projectPath, "README.md")) {
+		readmes = append([]string{"README.md"}, readmes...)
+	}
+
+	docsDir := filepath.Join(projectPath, "docs")
+	err = filepath.WalkDir(docsDir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !entry.IsDir() && strings.EqualFold(filepath.Ext(path), ".md") {
+			rel, err := filepath.Rel(projectPath, path)
+			if err != nil {
+				return err
+			}
+			docs = append(docs, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read docs directory: %w", err)
+	}
+	sort.Strings(docs)
+
+	return append(readmes, docs...), nil
+}
+
+// pageDocuments pages through first, then through any other document the user opens,
+// until they quit. choose picks a document from the list, returning "" to stop.
+func pageDocuments(projectPath, first string, documents []string, pager *docPager, choose func([]string) (string, error)) error {
+	pager.openable = true
+	current := first
+	for {
+		content, err := os.ReadFile(filepath.Join(projectPath, filepath.FromSlash(current)))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", current, err)
+		}
+
+		pager.load(current, string(content))
+		action, err := pager.run()
+		if err != nil || action == "quit" {
+			return err
+		}
+
+		next, err := choose(documents)
+		if err != nil || next == "" {
+			return err
+		}
+		current = next
+	}
+}
+
+// canPage reports whether someone is at a terminal to page through output
+func canPage() bool {
+	return autoAnswer == nil && stdoutIsTerminal() && term.IsTerminal(int(os.Stdin.Fd()))
+}
+
+// newTerminalPager creates a pager reading commands from the terminal. Accessible mode
+// shares its reader so input typed ahead is not lost.
+func newTerminalPager() *docPager {
+	var in io.Reader = os.Stdin
+	if accessibleMode {
+		in = stdioPrompter.in
+	}
+	return newDocPager(in, os.Stdout, pagerHeight(), colorEnabled())
+}
+
+// pagerHeight returns the terminal height, or the default when it is unknown
+func pagerHeight() int {
+	if _, height, err := term.GetSize(int(os.Stdout.Fd())); err == nil && height > 0 {
+		return height
+	}
+	return defaultPageHeight
+}
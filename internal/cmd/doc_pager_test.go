@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+// testDocument returns a Markdown document with numbered lines between three sections
+func testDocument() string {
+	var doc strings.Builder
+	doc.WriteString("# Shop API\n")
+	for i := 1; i <= 20; i++ {
+		fmt.Fprintf(&doc, "intro line %d\n", i)
+	}
+	doc.WriteString("## Setup\n```bash\n# not a heading\nmake run\n```\n")
+	for i := 1; i <= 20; i++ {
+		fmt.Fprintf(&doc, "setup line %d\n", i)
+	}
+	doc.WriteString("### Migrations\nRun the migrate script before starting.\n")
+	return doc.String()
+}
+
+func TestParseHeadings(t *testing.T) {
+	headings := parseHeadings(strings.Split(testDocument(), "\n"))
+
+	expected := []docHeading{
+		{Level: 1, Text: "Shop API", Line: 0},
+		{Level: 2, Text: "Setup", Line: 21},
+		{Level: 3, Text: "Migrations", Line: 46},
+	}
+	if !reflect.DeepEqual(headings, expected) {
+		t.Errorf("Expected %+v, got %+v", expected, headings)
+	}
+}
+
+func TestDocPagerCommands(t *testing.T) {
+	tests := []struct {
+		name        string
+		commands    string
+		expectedTop int
+	}{
+		{"next page", "\n", 10},
+		{"next and back", "\nb\n", 0},
+		{"bottom", "G\n", 38},
+		{"search", "/MIGRATE\n", 47},
+		{"search again", "/setup line\nn\n", 27},
+		{"search backwards wraps", "/setup line 1\nN\n", 44},
+		{"heading number", "2\n", 21},
+		{"next heading", "]\n]\n", 46},
+		{"previous heading", "G\n[\n", 21},
+		{"unknown command keeps place", "zz\n", 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var out bytes.Buffer
+			pager := newDocPager(strings.NewReader(tt.commands+"q\n"), &out, 12, false)
+			pager.load("README.md", testDocument())
+
+			action, err := pager.run()
+			if err != nil || action != "quit" {
+				t.Fatalf("Expected quit, got %q, %v", action, err)
+			}
+			if pager.top != tt.expectedTop {
+				t.Errorf("Expected top line %d, got %d", tt.expectedTop, pager.top)
+			}
+		})
+	}
+}
+
+func TestDocPagerOutput(t *testing.T) {
+	var out bytes.Buffer
+	pager := newDocPager(strings.NewReader("h\n/nothing here\n"), &out, 12, false)
+	pager.load("README.md", testDocument())
+
+	// The input ends without q; the pager quits at the end of input
+	if action, err := pager.run(); err != nil || action != "quit" {
+		t.Fatalf("Expected quit at end of input, got %q, %v", action, err)
+	}
+
+	for _, expected := range []string{
+		"# Shop API\nintro line 1\n",
+		"── README.md · lines 1-10 of 48 (20%)",
+		"   1. Shop API\n   2.   Setup\n   3.     Migrations\n",
+		"Pattern not found: nothing here",
+	} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got %q", expected, out.String())
+		}
+	}
+}
+
+func TestDocPagerPreviewCannotOpen(t *testing.T) {
+	var out bytes.Buffer
+	pager := newDocPager(strings.NewReader("o\nq\n"), &out, 12, false)
+	pager.load("Preview", testDocument())
+
+	// Code previews have no other document to open, so o is not a command
+	if action, err := pager.run(); err != nil || action != "quit" {
+		t.Fatalf("Expected quit, got %q, %v", action, err)
+	}
+	if !strings.Contains(out.String(), `Unknown command "o"`) {
+		t.Errorf("Expected o to be rejected, got %q", out.String())
+	}
+	if strings.Contains(out.String(), "o open") {
+		t.Errorf("Expected the status line not to offer o, got %q", out.String())
+	}
+}
+
+func TestFindProjectDocs(t *testing.T) {
+	projectPath := t.TempDir()
+	for _, file := range []string{"README.md", "README_product.md", "README_order.md", "NOTES.txt", "docs/guide.md", "docs/adr/0001-storage.md"} {
+		path := filepath.Join(projectPath, filepath.FromSlash(file))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create directory: %v", err)
+		}
+		if err := os.WriteFile(path, []byte("# "+file+"\n"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", file, err)
+		}
+	}
+
+	documents, err := findProjectDocs(projectPath)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	expected := []string{"README.md", "README_order.md", "README_product.md", "docs/adr/0001-storage.md", "docs/guide.md"}
+	if !reflect.DeepEqual(documents, expected) {
+		t.Errorf("Expected %v, got %v", expected, documents)
+	}
+}
+
+func TestPageDocumentsOpensAnotherDocument(t *testing.T) {
+	projectPath := t.TempDir()
+	for name, content := range map[string]string{"README.md": "# Shop\n", "README_product.md": "# Product API\n"} {
+		if err := os.WriteFile(filepath.Join(projectPath, name), []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	var out bytes.Buffer
+	pager := newDocPager(strings.NewReader("o\nq\n"), &out, 12, false)
+
+	var offered []string
+	choose := func(documents []string) (string, error) {
+		offered = documents
+		return "README_product.md", nil
+	}
+
+	if err := pageDocuments(projectPath, "README.md", []string{"README.md", "README_product.md"}, pager, choose); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if len(offered) != 2 {
+		t.Errorf("Expected both documents to be offered, got %v", offered)
+	}
+	if !strings.Contains(out.String(), "# Product API\n── README_product.md") {
+		t.Errorf("Expected the chosen document to be shown, got %q", out.String())
+	}
+}
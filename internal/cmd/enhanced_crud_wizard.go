@@ -5,6 +5,9 @@ import (
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/presets"
 )
 
 // ArchitectureLayer represents a layer in the clean architecture
@@ -106,9 +109,10 @@ func RunEnhancedCRUDWizard(projectPath string) error {
 	}
 
 	domainObj := &DomainObject{}
+	library := loadPresetLibrary(projectPath)
 
 	// Step 1: Domain Entity Design
-	if err := designDomainEntity(domainObj); err != nil {
+	if err := designDomainEntity(domainObj, library); err != nil {
 		if err == ErrUserQuit {
 			fmt.Println("👋 Thanks for using Gophex! Goodbye!")
 			return nil
@@ -161,7 +165,16 @@ func RunEnhancedCRUDWizard(projectPath string) error {
 		return err
 	}
 
-	// Step 7: Architecture Review and Generation
+	// Step 7: Layer Selection
+	if err := chooseLayersToGenerate(projectPath, domainObj); err != nil {
+		if err == ErrUserQuit {
+			fmt.Println("👋 Thanks for using Gophex! Goodbye!")
+			return nil
+		}
+		return err
+	}
+
+	// Step 8: Architecture Review and Generation
 	if err := reviewArchitectureAndGenerate(projectPath, domainObj); err != nil {
 		if err == ErrUserQuit {
 			fmt.Println("👋 Thanks for using Gophex! Goodbye!")
@@ -228,7 +241,7 @@ func showArchitectureOverview() error {
 		},
 	}
 
-	if err := survey.AskOne(proceedPrompt, &proceed); err != nil {
+	if err := askOne(proceedPrompt, &proceed); err != nil {
 		return err
 	}
 
@@ -277,11 +290,11 @@ func showDetailedArchitectureExplanation() error {
 		},
 	}
 
-	return survey.AskOne(readyPrompt, &ready)
+	return askOne(readyPrompt, &ready)
 }
 
 // designDomainEntity handles domain entity design with educational content
-func designDomainEntity(domainObj *DomainObject) error {
+func designDomainEntity(domainObj *DomainObject, library *presets.Library) error {
 	clearScreen()
 	fmt.Println("🏗️  Step 1: Domain Entity Design")
 	fmt.Println("The Domain Entity is the heart of your business logic.")
@@ -295,12 +308,12 @@ func designDomainEntity(domainObj *DomainObject) error {
 	fmt.Println()
 
 	// Use existing entity selection but with more education
-	if err := selectEntityWithEducation(&domainObj.Entity); err != nil {
+	if err := selectEntityWithEducation(&domainObj.Entity, library); err != nil {
 		return err
 	}
 
 	// Enhanced field definition with business rule consideration
-	if err := defineFieldsWithBusinessRules(&domainObj.Entity); err != nil {
+	if err := defineFieldsWithBusinessRules(&domainObj.Entity, library); err != nil {
 		return err
 	}
 
@@ -483,7 +496,7 @@ func configureMiddleware(domainObj *DomainObject) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -561,9 +574,11 @@ func visualizeDependencyInjection(domainObj *DomainObject) error {
 
 	fmt.Println("\n💡 Injection Points:")
 	fmt.Printf("1. Repository implementation injected into Service\n")
-	fmt.Printf("2. Service injected into Handler\n")
-	fmt.Printf("3. Handler registered with Router\n")
-	fmt.Printf("4. Middleware applied to routes\n")
+	fmt.Printf("2. Clock injected into Service (clock.System() in main.go, clock.Fake in tests)\n")
+	fmt.Printf("3. ID generator injected into repositories that assign IDs themselves\n")
+	fmt.Printf("4. Service injected into Handler\n")
+	fmt.Printf("5. Handler registered with Router\n")
+	fmt.Printf("6. Middleware applied to routes\n")
 
 	var proceed string
 	proceedPrompt := &survey.Select{
@@ -575,19 +590,19 @@ func visualizeDependencyInjection(domainObj *DomainObject) error {
 		},
 	}
 
-	return survey.AskOne(proceedPrompt, &proceed)
+	return askOne(proceedPrompt, &proceed)
 }
 
 // Helper functions for configuration
 
-func selectEntityWithEducation(entity *CRUDEntity) error {
+func selectEntityWithEducation(entity *CRUDEntity, library *presets.Library) error {
 	// Reuse existing selectEntity but with additional education
-	return selectEntity(entity)
+	return selectEntity(entity, library)
 }
 
-func defineFieldsWithBusinessRules(entity *CRUDEntity) error {
+func defineFieldsWithBusinessRules(entity *CRUDEntity, library *presets.Library) error {
 	// Enhanced version of defineFields that considers business rules
-	if err := defineFields(entity); err != nil {
+	if err := defineFields(entity, library); err != nil {
 		return err
 	}
 
@@ -597,7 +612,11 @@ func defineFieldsWithBusinessRules(entity *CRUDEntity) error {
 	fmt.Printf("• What makes a %s valid?\n", entity.Name)
 	fmt.Printf("• What business constraints should be enforced?\n")
 	fmt.Printf("• What happens when a %s is created/updated/deleted?\n", entity.Name)
-	fmt.Printf("• Are there any relationships with other entities?\n")
+	if len(entity.Relationships) > 0 {
+		fmt.Printf("• Should deleting a related record cascade to its %s?\n", entity.PluralName)
+	} else {
+		fmt.Printf("• Are there any relationships with other entities?\n")
+	}
 
 	return nil
 }
@@ -635,7 +654,7 @@ func configureRepositoryMethods(repo *RepositoryConfig, entity *CRUDEntity) erro
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -673,7 +692,7 @@ func configureRepositoryFeatures(repo *RepositoryConfig) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -729,7 +748,7 @@ func configureBusinessRules(service *ServiceConfig, entity *CRUDEntity) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -781,7 +800,7 @@ func configureDomainEvents(service *ServiceConfig, entity *CRUDEntity) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -818,7 +837,7 @@ func configureServiceFeatures(service *ServiceConfig) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -864,7 +883,7 @@ func configureAPIEndpoints(handler *HandlerConfig, entity *CRUDEntity) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -901,7 +920,7 @@ func configureHandlerFeatures(handler *HandlerConfig) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -915,8 +934,25 @@ func configureHandlerFeatures(handler *HandlerConfig) error {
 	return nil
 }
 
+// chooseLayersToGenerate lets the user generate only some layers, e.g. to embed the
+// domain and repository in an existing transport instead of the generated HTTP handlers
+func chooseLayersToGenerate(projectPath string, domainObj *DomainObject) error {
+	clearScreen()
+	fmt.Println("🧩 Step 7: Layer Selection")
+	fmt.Println("Generate every layer, or only the ones your project needs.")
+	fmt.Println()
+
+	fmt.Println("📚 Why generate fewer layers?")
+	fmt.Println("• Plug the domain and repository into an existing transport (gRPC, a queue consumer, another router)")
+	fmt.Println("• Keep a hand-written persistence layer behind the generated Repository interface")
+	fmt.Println("• Generate the rest later: the layers you pick are recorded in gophex.md")
+	fmt.Println()
+
+	return selectLayers(projectPath, &domainObj.Entity)
+}
+
 func reviewArchitectureAndGenerate(projectPath string, domainObj *DomainObject) error {
-	fmt.Println("\n🎯 Step 7: Architecture Review & Code Generation")
+	fmt.Println("\n🎯 Step 8: Architecture Review & Code Generation")
 	fmt.Println("Review your complete CRUD architecture before generation.")
 	fmt.Println()
 
@@ -962,20 +998,13 @@ func reviewArchitectureAndGenerate(projectPath string, domainObj *DomainObject)
 	fmt.Printf("   • Middleware: %d components\n", enabledMiddleware)
 
 	// Show file structure
+	databaseType, err := getDatabaseType(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to determine database type: %w", err)
+	}
+
 	fmt.Println("\n📁 Files to be generated:")
-	files := []string{
-		fmt.Sprintf("internal/domain/%s/model.go", domainObj.Entity.Name),
-		fmt.Sprintf("internal/domain/%s/repository.go", domainObj.Entity.Name),
-		fmt.Sprintf("internal/domain/%s/service.go", domainObj.Entity.Name),
-		fmt.Sprintf("internal/domain/%s/errors.go", domainObj.Entity.Name),
-		fmt.Sprintf("internal/infrastructure/repository/%s_repository.go", domainObj.Entity.Name),
-		fmt.Sprintf("internal/api/handlers/%s.go", domainObj.Entity.Name),
-		fmt.Sprintf("internal/api/middleware/"),
-		fmt.Sprintf("migrations/create_%s_table.sql", domainObj.Entity.PluralName),
-		fmt.Sprintf("docs/%s_api.md", domainObj.Entity.Name),
-	}
-
-	for _, file := range files {
+	for _, file := range crudLayerFiles(&domainObj.Entity, databaseType) {
 		fmt.Printf("   • %s\n", file)
 	}
 
@@ -985,12 +1014,14 @@ func reviewArchitectureAndGenerate(projectPath string, domainObj *DomainObject)
 		Message: "Generate this complete CRUD architecture?",
 		Options: []string{
 			"Yes - Generate all files with educational comments",
-			"No - Let me modify the configuration",
+			"Edit - Modify the entity fields",
+			"No - Cancel generation",
 			"Quit",
 		},
+		Help: "Edit lets you rename, retype, reorder or delete fields and toggle required/unique",
 	}
 
-	if err := survey.AskOne(confirmPrompt, &confirm); err != nil {
+	if err := askOne(confirmPrompt, &confirm); err != nil {
 		return err
 	}
 
@@ -998,8 +1029,15 @@ func reviewArchitectureAndGenerate(projectPath string, domainObj *DomainObject)
 		return ErrUserQuit
 	}
 
+	if strings.HasPrefix(confirm, "Edit") {
+		if err := editFields(&domainObj.Entity); err != nil {
+			return err
+		}
+		return reviewArchitectureAndGenerate(projectPath, domainObj)
+	}
+
 	if confirm[:2] == "No" {
-		fmt.Println("You can restart the wizard to modify your configuration.")
+		fmt.Println("❌ CRUD generation cancelled")
 		return nil
 	}
 
@@ -1011,18 +1049,22 @@ func generateEnhancedCRUDCode(projectPath string, domainObj *DomainObject) error
 	fmt.Println("\n🚀 Generating Enhanced CRUD Architecture...")
 	fmt.Println()
 
-	// This would integrate with the existing generator but with enhanced templates
-	// that include educational comments and clean architecture patterns
+	// The standard endpoints designed in Step 4 replace the whole entity with PUT
+	if domainObj.Entity.UpdateMethod == "" {
+		domainObj.Entity.UpdateMethod = "put"
+	}
+
+	if err := generateCRUDCode(projectPath, &domainObj.Entity); err != nil {
+		return err
+	}
 
-	fmt.Printf("✅ Successfully generated enhanced CRUD architecture for %s!\n", domainObj.Entity.Name)
-	fmt.Println()
 	fmt.Println("📚 What was generated:")
-	fmt.Println("• Complete Clean Architecture implementation")
-	fmt.Println("• Educational comments explaining each pattern")
-	fmt.Println("• Dependency injection setup")
-	fmt.Println("• Comprehensive test examples")
+	for _, layer := range metadata.AllLayers {
+		if domainObj.Entity.HasLayer(layer) {
+			fmt.Printf("• %s layer: %s\n", layer, layerDescriptions[layer])
+		}
+	}
 	fmt.Println("• API documentation")
-	fmt.Println("• Migration files")
 	fmt.Println()
 	fmt.Println("🎓 Next Steps:")
 	fmt.Println("1. Review the generated code and comments")
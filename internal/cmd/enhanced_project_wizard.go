@@ -17,6 +17,9 @@ type ProjectConfiguration struct {
 	Framework      string
 	DatabaseConfig *generator.DatabaseConfig
 	RedisConfig    *generator.RedisConfig
+	DockerConfig   *generator.DockerConfig
+	GoConfig       *generator.GoConfig
+	MinimalDeps    bool
 	Path           string
 	Features       []ProjectFeature
 }
@@ -29,6 +32,29 @@ type ProjectFeature struct {
 	Educational string
 }
 
+// Features whose selection changes the generated code
+const (
+	structuredLoggingFeature  = "Structured Logging"
+	distributedTracingFeature = "Distributed Tracing"
+)
+
+// featureEnabled reports whether the named feature was selected
+func (c *ProjectConfiguration) featureEnabled(name string) bool {
+	for _, feature := range c.Features {
+		if feature.Name == name {
+			return feature.Enabled
+		}
+	}
+	return false
+}
+
+// tracingEnabled reports whether the API gets OpenTelemetry tracing; its point is
+// correlating traces with the structured logs, so it needs both features
+func (c *ProjectConfiguration) tracingEnabled() bool {
+	return c.Type == "api" && !c.MinimalDeps &&
+		c.featureEnabled(structuredLoggingFeature) && c.featureEnabled(distributedTracingFeature)
+}
+
 // RunEnhancedProjectWizard runs the enhanced educational project generation wizard
 func RunEnhancedProjectWizard() error {
 	clearScreen()
@@ -65,8 +91,26 @@ func RunEnhancedProjectWizard() error {
 		return err
 	}
 
-	// Step 4: Framework Selection (if applicable)
-	if config.Type == "api" {
+	// Step 3b: Go Version
+	if err := selectGoVersionWithEducation(config); err != nil {
+		if err == ErrUserQuit {
+			fmt.Println("👋 Thanks for using Gophex! Goodbye!")
+			return nil
+		}
+		return err
+	}
+
+	// Step 3c: Dependency Policy
+	if err := selectDependencyPolicyWithEducation(config); err != nil {
+		if err == ErrUserQuit {
+			fmt.Println("👋 Thanks for using Gophex! Goodbye!")
+			return nil
+		}
+		return err
+	}
+
+	// Step 4: Framework Selection (if applicable; minimal mode routes with net/http)
+	if config.Type == "api" && !config.MinimalDeps {
 		if err := selectFrameworkWithEducation(config); err != nil {
 			if err == ErrUserQuit {
 				fmt.Println("👋 Thanks for using Gophex! Goodbye!")
@@ -87,6 +131,17 @@ func RunEnhancedProjectWizard() error {
 		}
 	}
 
+	// Step 5b: Container Image (API projects ship a Dockerfile)
+	if config.Type == "api" {
+		if err := selectRuntimeImageWithEducation(config); err != nil {
+			if err == ErrUserQuit {
+				fmt.Println("👋 Thanks for using Gophex! Goodbye!")
+				return nil
+			}
+			return err
+		}
+	}
+
 	// Step 6: Feature Selection and Configuration
 	if err := configureProjectFeatures(config); err != nil {
 		if err == ErrUserQuit {
@@ -179,7 +234,7 @@ func showProjectArchitectureOverview() error {
 		},
 	}
 
-	if err := survey.AskOne(proceedPrompt, &proceed); err != nil {
+	if err := askOne(proceedPrompt, &proceed); err != nil {
 		return err
 	}
 
@@ -257,7 +312,7 @@ func explainCleanArchitecture() error {
 		},
 	}
 
-	if err := survey.AskOne(readyPrompt, &ready); err != nil {
+	if err := askOne(readyPrompt, &ready); err != nil {
 		return err
 	}
 
@@ -274,40 +329,42 @@ func explainCleanArchitecture() error {
 
 // showCleanArchitectureExample shows a concrete example
 func showCleanArchitectureExample() error {
-	fmt.Println("\n💡 Clean Architecture Example: User Management API")
-	fmt.Println()
-
-	fmt.Println("📁 Project Structure:")
-	fmt.Println("```")
-	fmt.Println("internal/")
-	fmt.Println("├── domain/              # 🏛️  Domain Layer")
-	fmt.Println("│   └── user/")
-	fmt.Println("│       ├── user.go      # User entity with business rules")
-	fmt.Println("│       └── repository.go # Repository interface (contract)")
-	fmt.Println("├── application/         # 🔧 Application Layer")
-	fmt.Println("│   └── user/")
-	fmt.Println("│       └── service.go   # CreateUser, UpdateUser use cases")
-	fmt.Println("├── infrastructure/      # 🔌 Interface Adapters")
-	fmt.Println("│   ├── http/")
-	fmt.Println("│   │   └── user_handler.go # HTTP handlers")
-	fmt.Println("│   └── database/")
-	fmt.Println("│       └── user_repo.go    # Database implementation")
-	fmt.Println("└── main.go             # 🌐 Framework & Drivers")
-	fmt.Println("```")
-	fmt.Println()
-
-	fmt.Println("🔄 Data Flow Example (Create User):")
-	fmt.Println("1. HTTP Request → UserHandler (Infrastructure)")
-	fmt.Println("2. Handler validates input → calls UserService (Application)")
-	fmt.Println("3. Service applies business rules → calls UserRepository (Domain Interface)")
-	fmt.Println("4. Repository saves to database (Infrastructure Implementation)")
-	fmt.Println("5. Response flows back through the layers")
-	fmt.Println()
-
-	fmt.Println("🧪 Testing Benefits:")
-	fmt.Println("• Test business logic without database (mock repository)")
-	fmt.Println("• Test use cases without HTTP (call service directly)")
-	fmt.Println("• Test handlers without business logic (mock service)")
+	var preview strings.Builder
+	fmt.Fprintln(&preview, "\n💡 Clean Architecture Example: User Management API")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "📁 Project Structure:")
+	fmt.Fprintln(&preview, "```")
+	fmt.Fprintln(&preview, "internal/")
+	fmt.Fprintln(&preview, "├── domain/              # 🏛️  Domain Layer")
+	fmt.Fprintln(&preview, "│   └── user/")
+	fmt.Fprintln(&preview, "│       ├── user.go      # User entity with business rules")
+	fmt.Fprintln(&preview, "│       └── repository.go # Repository interface (contract)")
+	fmt.Fprintln(&preview, "├── application/         # 🔧 Application Layer")
+	fmt.Fprintln(&preview, "│   └── user/")
+	fmt.Fprintln(&preview, "│       └── service.go   # CreateUser, UpdateUser use cases")
+	fmt.Fprintln(&preview, "├── infrastructure/      # 🔌 Interface Adapters")
+	fmt.Fprintln(&preview, "│   ├── http/")
+	fmt.Fprintln(&preview, "│   │   └── user_handler.go # HTTP handlers")
+	fmt.Fprintln(&preview, "│   └── database/")
+	fmt.Fprintln(&preview, "│       └── user_repo.go    # Database implementation")
+	fmt.Fprintln(&preview, "└── main.go             # 🌐 Framework & Drivers")
+	fmt.Fprintln(&preview, "```")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "🔄 Data Flow Example (Create User):")
+	fmt.Fprintln(&preview, "1. HTTP Request → UserHandler (Infrastructure)")
+	fmt.Fprintln(&preview, "2. Handler validates input → calls UserService (Application)")
+	fmt.Fprintln(&preview, "3. Service applies business rules → calls UserRepository (Domain Interface)")
+	fmt.Fprintln(&preview, "4. Repository saves to database (Infrastructure Implementation)")
+	fmt.Fprintln(&preview, "5. Response flows back through the layers")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "🧪 Testing Benefits:")
+	fmt.Fprintln(&preview, "• Test business logic without database (mock repository)")
+	fmt.Fprintln(&preview, "• Test use cases without HTTP (call service directly)")
+	fmt.Fprintln(&preview, "• Test handlers without business logic (mock service)")
+	showPaged(preview.String())
 
 	var proceed string
 	proceedPrompt := &survey.Select{
@@ -318,7 +375,7 @@ func showCleanArchitectureExample() error {
 		},
 	}
 
-	return survey.AskOne(proceedPrompt, &proceed)
+	return askOne(proceedPrompt, &proceed)
 }
 
 // explainProjectTypeDifferences explains the differences between project types
@@ -418,7 +475,7 @@ func explainProjectTypeDifferences() error {
 		},
 	}
 
-	return survey.AskOne(proceedPrompt, &proceed)
+	return askOne(proceedPrompt, &proceed)
 }
 
 // selectProjectTypeWithEducation handles project type selection with educational content
@@ -443,7 +500,7 @@ func selectProjectTypeWithEducation(config *ProjectConfiguration) error {
 		Help:    "Each type teaches different Go patterns and architectures",
 	}
 
-	if err := survey.AskOne(typePrompt, &selected); err != nil {
+	if err := askOne(typePrompt, &selected); err != nil {
 		return err
 	}
 
@@ -562,7 +619,7 @@ func explainSelectedProjectType(projectType string) error {
 		},
 	}
 
-	if err := survey.AskOne(proceedPrompt, &proceed); err != nil {
+	if err := askOne(proceedPrompt, &proceed); err != nil {
 		return err
 	}
 
@@ -577,6 +634,14 @@ func explainSelectedProjectType(projectType string) error {
 	return nil
 }
 
+// defaultProjectName is the project name offered for a project type, e.g. my-api
+func defaultProjectName(projectType string) string {
+	if projectType == "" {
+		return "my-project"
+	}
+	return "my-" + projectType
+}
+
 // configureProjectBasics handles project name and path configuration
 func configureProjectBasics(config *ProjectConfiguration) error {
 	clearScreen()
@@ -588,9 +653,10 @@ func configureProjectBasics(config *ProjectConfiguration) error {
 	namePrompt := &survey.Input{
 		Message: "What is the name of your project?",
 		Help:    "This will be used as the directory name and Go module name. Use lowercase with hyphens (e.g., 'my-api', 'user-service')",
+		Default: defaultProjectName(config.Type),
 	}
 
-	if err := survey.AskOne(namePrompt, &config.Name, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(namePrompt, &config.Name, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -613,7 +679,7 @@ func configureProjectBasics(config *ProjectConfiguration) error {
 		},
 	}
 
-	if err := survey.AskOne(confirmPrompt, &confirm); err != nil {
+	if err := askOne(confirmPrompt, &confirm); err != nil {
 		return err
 	}
 
@@ -630,7 +696,7 @@ func configureProjectBasics(config *ProjectConfiguration) error {
 			Help:    "The project folder will be created inside this directory",
 		}
 
-		if err := survey.AskOne(pathPrompt, &customPath, survey.WithValidator(survey.Required)); err != nil {
+		if err := askOne(pathPrompt, &customPath, survey.WithValidator(survey.Required)); err != nil {
 			return err
 		}
 
@@ -702,7 +768,7 @@ func selectFrameworkWithEducation(config *ProjectConfiguration) error {
 		Help:    "Each framework teaches different patterns and approaches",
 	}
 
-	if err := survey.AskOne(frameworkPrompt, &selected); err != nil {
+	if err := askOne(frameworkPrompt, &selected); err != nil {
 		return err
 	}
 
@@ -728,54 +794,182 @@ func selectFrameworkWithEducation(config *ProjectConfiguration) error {
 	return explainFrameworkChoice(config.Framework)
 }
 
-// showFrameworkComparison provides detailed framework comparison
-func showFrameworkComparison(config *ProjectConfiguration) error {
-	fmt.Println("\n📊 Detailed Framework Comparison")
+// selectGoVersionWithEducation explains the go and toolchain directives and asks for the target release
+func selectGoVersionWithEducation(config *ProjectConfiguration) error {
+	fmt.Println("\n🐹 Go Version:")
+	fmt.Println("go.mod records the oldest Go release the code may use (the go directive)")
+	fmt.Println("and, optionally, the toolchain that builds it (the toolchain directive).")
 	fmt.Println()
 
-	fmt.Println("🏃 Performance Comparison:")
-	fmt.Println("• Gin: ~40,000 req/sec (fastest)")
-	fmt.Println("• Echo: ~35,000 req/sec (very fast)")
-	fmt.Println("• Gorilla: ~25,000 req/sec (good performance)")
+	fmt.Println("🎓 Why it matters:")
+	fmt.Println("• Language features and standard library APIs are gated by the go directive")
+	fmt.Println("• Go 1.22 added method and wildcard patterns to net/http's ServeMux")
+	fmt.Println("• Older go commands download the toolchain go.mod asks for (GOTOOLCHAIN=auto)")
 	fmt.Println()
 
-	fmt.Println("📚 Learning Curve:")
-	fmt.Println("• Gin: Easy (simple API, good docs)")
-	fmt.Println("• Echo: Medium (more features, modern patterns)")
-	fmt.Println("• Gorilla: Medium-Hard (flexible but complex)")
-	fmt.Println()
+	detected, err := generator.DetectGoConfig()
+	if err != nil {
+		fmt.Println("⚠️  Could not detect an installed Go toolchain; no toolchain directive will be written")
+		detected = nil
+	}
 
-	fmt.Println("🔧 Middleware Ecosystem:")
-	fmt.Println("• Gin: Large ecosystem, many third-party packages")
-	fmt.Println("• Echo: Built-in middleware, growing ecosystem")
-	fmt.Println("• Gorilla: Rich toolkit, enterprise-focused")
+	options, defaultOption := goVersionOptions(detected)
+	var selected string
+	versionPrompt := &survey.Select{
+		Message: "Which Go version should the project target?",
+		Options: append(options, "Quit"),
+		Default: defaultOption,
+		Help:    "Choose the oldest release your team and CI will build with",
+	}
+
+	if err := askOne(versionPrompt, &selected); err != nil {
+		return err
+	}
+
+	if selected == "Quit" {
+		return ErrUserQuit
+	}
+
+	config.GoConfig = goConfigForChoice(goVersionName(selected), detected)
+
+	if config.GoConfig.Toolchain != "" {
+		fmt.Printf("✅ go %s, toolchain %s\n", config.GoConfig.Version, config.GoConfig.Toolchain)
+	} else {
+		fmt.Printf("✅ go %s\n", config.GoConfig.Version)
+	}
+	return nil
+}
+
+// selectDependencyPolicyWithEducation explains minimal dependency mode and asks whether to use it
+func selectDependencyPolicyWithEducation(config *ProjectConfiguration) error {
+	if !minimalDepsAvailable(config.Type, config.GoConfig) {
+		return nil
+	}
+
+	fmt.Println("\n📦 Dependency Policy:")
+	fmt.Println("Every third-party module is code your team has to review, update and trust.")
 	fmt.Println()
 
-	fmt.Println("🎯 Code Example Comparison:")
+	fmt.Println("🎓 What minimal mode swaps for the standard library:")
+	fmt.Println("• Routing: net/http ServeMux patterns instead of a web framework or gorilla/mux")
+	fmt.Println("• Logging and data: log/slog and database/sql (PostgreSQL or MySQL)")
+	fmt.Println("• Config and auth: encoding/json config files and HS256 tokens via crypto/hmac")
+	fmt.Println("• CLIs: the flag package instead of Cobra")
+	fmt.Println("• Kept: the database driver and golang.org/x/crypto for bcrypt; Redis is not offered")
 	fmt.Println()
 
-	fmt.Println("Gin:")
-	fmt.Println("```go")
-	fmt.Println("r := gin.Default()")
-	fmt.Println("r.GET(\"/users/:id\", getUserHandler)")
-	fmt.Println("r.Run(\":8080\")")
-	fmt.Println("```")
+	var selected string
+	policyPrompt := &survey.Select{
+		Message: "Which dependency policy should the project follow?",
+		Options: append(append([]string{}, dependencyPolicyOptions...), "Quit"),
+		Help:    "Choose Minimal if your organization restricts third-party modules",
+	}
+
+	if err := askOne(policyPrompt, &selected); err != nil {
+		return err
+	}
+
+	if selected == "Quit" {
+		return ErrUserQuit
+	}
+
+	config.MinimalDeps = strings.HasPrefix(selected, "Minimal")
+	if config.MinimalDeps {
+		fmt.Println("✅ Minimal dependencies: standard library wherever possible")
+	} else {
+		fmt.Println("✅ Standard dependencies")
+	}
+	return nil
+}
+
+// selectRuntimeImageWithEducation explains multi-stage builds and asks for the runtime image
+func selectRuntimeImageWithEducation(config *ProjectConfiguration) error {
+	fmt.Println("\n🐳 Container Image:")
+	fmt.Println("Your API gets a multi-stage Dockerfile: Go compiles in a build image,")
+	fmt.Println("and only the static binary is copied into a small runtime image.")
 	fmt.Println()
 
-	fmt.Println("Echo:")
-	fmt.Println("```go")
-	fmt.Println("e := echo.New()")
-	fmt.Println("e.GET(\"/users/:id\", getUserHandler)")
-	fmt.Println("e.Start(\":8080\")")
-	fmt.Println("```")
+	fmt.Println("🎓 Why it matters:")
+	fmt.Println("• Smaller images pull faster and contain fewer packages to patch")
+	fmt.Println("• The container runs as a non-root user, limiting what an attacker can do")
+	fmt.Println("• A HEALTHCHECK lets Docker and orchestrators restart unhealthy containers")
+	fmt.Println("• buildx cross-compiles for amd64 and arm64 (Apple Silicon, AWS Graviton)")
 	fmt.Println()
 
-	fmt.Println("Gorilla:")
-	fmt.Println("```go")
-	fmt.Println("r := mux.NewRouter()")
-	fmt.Println("r.HandleFunc(\"/users/{id}\", getUserHandler).Methods(\"GET\")")
-	fmt.Println("http.ListenAndServe(\":8080\", r)")
-	fmt.Println("```")
+	var selected string
+	imagePrompt := &survey.Select{
+		Message: "Which runtime image should the Dockerfile use?",
+		Options: append(append([]string{}, runtimeImageOptions...), "Quit"),
+		Help:    "distroless is the safest default; alpine helps when you need to exec into the container",
+	}
+
+	if err := askOne(imagePrompt, &selected); err != nil {
+		return err
+	}
+
+	if selected == "Quit" {
+		return ErrUserQuit
+	}
+
+	config.DockerConfig = &generator.DockerConfig{
+		RuntimeImage: runtimeImageName(selected),
+		Platforms:    generator.DefaultPlatforms,
+	}
+
+	fmt.Printf("✅ Runtime image: %s, platforms: %s\n", config.DockerConfig.RuntimeImage, strings.Join(config.DockerConfig.Platforms, ", "))
+	return nil
+}
+
+// showFrameworkComparison provides detailed framework comparison
+func showFrameworkComparison(config *ProjectConfiguration) error {
+	var preview strings.Builder
+	fmt.Fprintln(&preview, "\n📊 Detailed Framework Comparison")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "🏃 Performance Comparison:")
+	fmt.Fprintln(&preview, "• Gin: ~40,000 req/sec (fastest)")
+	fmt.Fprintln(&preview, "• Echo: ~35,000 req/sec (very fast)")
+	fmt.Fprintln(&preview, "• Gorilla: ~25,000 req/sec (good performance)")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "📚 Learning Curve:")
+	fmt.Fprintln(&preview, "• Gin: Easy (simple API, good docs)")
+	fmt.Fprintln(&preview, "• Echo: Medium (more features, modern patterns)")
+	fmt.Fprintln(&preview, "• Gorilla: Medium-Hard (flexible but complex)")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "🔧 Middleware Ecosystem:")
+	fmt.Fprintln(&preview, "• Gin: Large ecosystem, many third-party packages")
+	fmt.Fprintln(&preview, "• Echo: Built-in middleware, growing ecosystem")
+	fmt.Fprintln(&preview, "• Gorilla: Rich toolkit, enterprise-focused")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "🎯 Code Example Comparison:")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "Gin:")
+	fmt.Fprintln(&preview, "```go")
+	fmt.Fprintln(&preview, "r := gin.Default()")
+	fmt.Fprintln(&preview, "r.GET(\"/users/:id\", getUserHandler)")
+	fmt.Fprintln(&preview, "r.Run(\":8080\")")
+	fmt.Fprintln(&preview, "```")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "Echo:")
+	fmt.Fprintln(&preview, "```go")
+	fmt.Fprintln(&preview, "e := echo.New()")
+	fmt.Fprintln(&preview, "e.GET(\"/users/:id\", getUserHandler)")
+	fmt.Fprintln(&preview, "e.Start(\":8080\")")
+	fmt.Fprintln(&preview, "```")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "Gorilla:")
+	fmt.Fprintln(&preview, "```go")
+	fmt.Fprintln(&preview, "r := mux.NewRouter()")
+	fmt.Fprintln(&preview, "r.HandleFunc(\"/users/{id}\", getUserHandler).Methods(\"GET\")")
+	fmt.Fprintln(&preview, "http.ListenAndServe(\":8080\", r)")
+	fmt.Fprintln(&preview, "```")
+	showPaged(preview.String())
 
 	// Return to framework selection
 	return selectFrameworkWithEducation(config)
@@ -837,7 +1031,7 @@ func explainFrameworkChoice(framework string) error {
 		},
 	}
 
-	if err := survey.AskOne(proceedPrompt, &proceed); err != nil {
+	if err := askOne(proceedPrompt, &proceed); err != nil {
 		return err
 	}
 
@@ -872,8 +1066,10 @@ func designDatabaseArchitecture(config *ProjectConfiguration) error {
 		return err
 	}
 
-	// Redis configuration
-	if err := configureRedisWithEducation(config); err != nil {
+	// Redis configuration; minimal mode has no Redis client
+	if config.MinimalDeps {
+		config.RedisConfig = &generator.RedisConfig{}
+	} else if err := configureRedisWithEducation(config); err != nil {
 		return err
 	}
 
@@ -929,10 +1125,14 @@ func selectDatabaseWithEducation(config *ProjectConfiguration) error {
 	dbOptions := []string{
 		"PostgreSQL - Advanced relational database (recommended for learning)",
 		"MySQL - Popular and simple relational database",
-		"MongoDB - Flexible document database",
-		"Compare databases in detail",
-		"Quit",
 	}
+	if config.MinimalDeps {
+		fmt.Println("📦 Minimal dependency mode uses database/sql, so MongoDB is not offered")
+		fmt.Println()
+	} else {
+		dbOptions = append(dbOptions, "MongoDB - Flexible document database")
+	}
+	dbOptions = append(dbOptions, "Compare databases in detail", "Quit")
 
 	var selected string
 	dbPrompt := &survey.Select{
@@ -941,7 +1141,7 @@ func selectDatabaseWithEducation(config *ProjectConfiguration) error {
 		Help:    "Each database teaches different data modeling approaches",
 	}
 
-	if err := survey.AskOne(dbPrompt, &selected); err != nil {
+	if err := askOne(dbPrompt, &selected); err != nil {
 		return err
 	}
 
@@ -1106,7 +1306,7 @@ func selectDatabaseConfigurationWithEducation(config *ProjectConfiguration) erro
 		Help:    "Start simple and scale up as you learn more patterns",
 	}
 
-	if err := survey.AskOne(configPrompt, &selected); err != nil {
+	if err := askOne(configPrompt, &selected); err != nil {
 		return err
 	}
 
@@ -1145,7 +1345,7 @@ func getDatabaseCredentialsWithEducation(dbConfig *generator.DatabaseConfig, pro
 		Default: projectName + "_db",
 		Help:    "The name of the database to connect to",
 	}
-	if err := survey.AskOne(dbNamePrompt, &dbConfig.DatabaseName, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(dbNamePrompt, &dbConfig.DatabaseName, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1155,7 +1355,7 @@ func getDatabaseCredentialsWithEducation(dbConfig *generator.DatabaseConfig, pro
 		Default: "admin",
 		Help:    "Database user with appropriate permissions",
 	}
-	if err := survey.AskOne(usernamePrompt, &dbConfig.Username, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(usernamePrompt, &dbConfig.Username, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1164,7 +1364,7 @@ func getDatabaseCredentialsWithEducation(dbConfig *generator.DatabaseConfig, pro
 		Message: "Database password:",
 		Help:    "This will be stored in environment variables, not in code",
 	}
-	if err := survey.AskOne(passwordPrompt, &dbConfig.Password, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(passwordPrompt, &dbConfig.Password, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1193,7 +1393,7 @@ func configureSingleInstance(dbConfig *generator.DatabaseConfig) error {
 		Default: "localhost",
 		Help:    "Hostname or IP address of your database server",
 	}
-	if err := survey.AskOne(hostPrompt, &dbConfig.Host, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(hostPrompt, &dbConfig.Host, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1213,7 +1413,7 @@ func configureSingleInstance(dbConfig *generator.DatabaseConfig) error {
 		Default: defaultPort,
 		Help:    "Port number for your database server",
 	}
-	if err := survey.AskOne(portPrompt, &dbConfig.Port, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(portPrompt, &dbConfig.Port, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1226,7 +1426,7 @@ func configureSingleInstance(dbConfig *generator.DatabaseConfig) error {
 			Default: "disable",
 			Help:    "SSL connection mode (use 'require' or higher in production)",
 		}
-		if err := survey.AskOne(sslPrompt, &sslMode); err != nil {
+		if err := askOne(sslPrompt, &sslMode); err != nil {
 			return err
 		}
 		dbConfig.SSLMode = sslMode
@@ -1247,7 +1447,7 @@ func configureReadWriteSplit(dbConfig *generator.DatabaseConfig) error {
 		Default: "localhost",
 		Help:    "Primary database server for write operations",
 	}
-	if err := survey.AskOne(writeHostPrompt, &dbConfig.WriteHost, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(writeHostPrompt, &dbConfig.WriteHost, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1257,7 +1457,7 @@ func configureReadWriteSplit(dbConfig *generator.DatabaseConfig) error {
 		Default: "localhost-replica",
 		Help:    "Read replica server for read operations",
 	}
-	if err := survey.AskOne(readHostPrompt, &dbConfig.ReadHost, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(readHostPrompt, &dbConfig.ReadHost, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1276,7 +1476,7 @@ func configureReadWriteSplit(dbConfig *generator.DatabaseConfig) error {
 		Message: "Database port:",
 		Default: defaultPort,
 	}
-	return survey.AskOne(portPrompt, &dbConfig.Port, survey.WithValidator(survey.Required))
+	return askOne(portPrompt, &dbConfig.Port, survey.WithValidator(survey.Required))
 }
 
 // configureCluster configures cluster setup
@@ -1293,7 +1493,7 @@ func configureCluster(dbConfig *generator.DatabaseConfig) error {
 			Default: fmt.Sprintf("db-node-%d.cluster.local", i+1),
 			Help:    "Hostname of cluster node",
 		}
-		if err := survey.AskOne(nodePrompt, &dbConfig.ClusterNodes[i], survey.WithValidator(survey.Required)); err != nil {
+		if err := askOne(nodePrompt, &dbConfig.ClusterNodes[i], survey.WithValidator(survey.Required)); err != nil {
 			return err
 		}
 	}
@@ -1313,7 +1513,7 @@ func configureCluster(dbConfig *generator.DatabaseConfig) error {
 		Message: "Database port:",
 		Default: defaultPort,
 	}
-	return survey.AskOne(portPrompt, &dbConfig.Port, survey.WithValidator(survey.Required))
+	return askOne(portPrompt, &dbConfig.Port, survey.WithValidator(survey.Required))
 }
 
 // configureRedisWithEducation handles Redis configuration with educational content
@@ -1341,7 +1541,7 @@ func configureRedisWithEducation(config *ProjectConfiguration) error {
 		Help: "Redis adds powerful caching and session management capabilities",
 	}
 
-	if err := survey.AskOne(redisPrompt, &redisChoice); err != nil {
+	if err := askOne(redisPrompt, &redisChoice); err != nil {
 		return err
 	}
 
@@ -1366,47 +1566,49 @@ func configureRedisWithEducation(config *ProjectConfiguration) error {
 
 // explainRedisPatterns explains Redis usage patterns
 func explainRedisPatterns(config *ProjectConfiguration) error {
-	fmt.Println("\n🔍 Redis Patterns You'll Learn:")
-	fmt.Println()
-
-	fmt.Println("1. 🏃 Caching Pattern:")
-	fmt.Println("   • Cache database query results")
-	fmt.Println("   • Reduce database load and improve response times")
-	fmt.Println("   • Cache invalidation strategies")
-	fmt.Println()
-
-	fmt.Println("2. 🎫 Session Management:")
-	fmt.Println("   • Store user sessions in Redis")
-	fmt.Println("   • Enable horizontal scaling of your API")
-	fmt.Println("   • Session expiration and cleanup")
-	fmt.Println()
-
-	fmt.Println("3. 🚦 Rate Limiting:")
-	fmt.Println("   • Implement API rate limiting")
-	fmt.Println("   • Prevent abuse and ensure fair usage")
-	fmt.Println("   • Different rate limiting algorithms")
-	fmt.Println()
-
-	fmt.Println("4. 📡 Pub/Sub Messaging:")
-	fmt.Println("   • Real-time notifications")
-	fmt.Println("   • Event-driven architecture")
-	fmt.Println("   • Microservice communication")
-	fmt.Println()
-
-	fmt.Println("💡 Code Example - Caching:")
-	fmt.Println("```go")
-	fmt.Println("// Check cache first")
-	fmt.Println("user, err := redis.Get(\"user:\" + userID)")
-	fmt.Println("if err == nil {")
-	fmt.Println("    return user // Cache hit!")
-	fmt.Println("}")
-	fmt.Println()
-	fmt.Println("// Cache miss - get from database")
-	fmt.Println("user, err = db.GetUser(userID)")
-	fmt.Println("if err == nil {")
-	fmt.Println("    redis.Set(\"user:\" + userID, user, 5*time.Minute)")
-	fmt.Println("}")
-	fmt.Println("```")
+	var preview strings.Builder
+	fmt.Fprintln(&preview, "\n🔍 Redis Patterns You'll Learn:")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "1. 🏃 Caching Pattern:")
+	fmt.Fprintln(&preview, "   • Cache database query results")
+	fmt.Fprintln(&preview, "   • Reduce database load and improve response times")
+	fmt.Fprintln(&preview, "   • Cache invalidation strategies")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "2. 🎫 Session Management:")
+	fmt.Fprintln(&preview, "   • Store user sessions in Redis")
+	fmt.Fprintln(&preview, "   • Enable horizontal scaling of your API")
+	fmt.Fprintln(&preview, "   • Session expiration and cleanup")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "3. 🚦 Rate Limiting:")
+	fmt.Fprintln(&preview, "   • Implement API rate limiting")
+	fmt.Fprintln(&preview, "   • Prevent abuse and ensure fair usage")
+	fmt.Fprintln(&preview, "   • Different rate limiting algorithms")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "4. 📡 Pub/Sub Messaging:")
+	fmt.Fprintln(&preview, "   • Real-time notifications")
+	fmt.Fprintln(&preview, "   • Event-driven architecture")
+	fmt.Fprintln(&preview, "   • Microservice communication")
+	fmt.Fprintln(&preview)
+
+	fmt.Fprintln(&preview, "💡 Code Example - Caching:")
+	fmt.Fprintln(&preview, "```go")
+	fmt.Fprintln(&preview, "// Check cache first")
+	fmt.Fprintln(&preview, "user, err := redis.Get(\"user:\" + userID)")
+	fmt.Fprintln(&preview, "if err == nil {")
+	fmt.Fprintln(&preview, "    return user // Cache hit!")
+	fmt.Fprintln(&preview, "}")
+	fmt.Fprintln(&preview)
+	fmt.Fprintln(&preview, "// Cache miss - get from database")
+	fmt.Fprintln(&preview, "user, err = db.GetUser(userID)")
+	fmt.Fprintln(&preview, "if err == nil {")
+	fmt.Fprintln(&preview, "    redis.Set(\"user:\" + userID, user, 5*time.Minute)")
+	fmt.Fprintln(&preview, "}")
+	fmt.Fprintln(&preview, "```")
+	showPaged(preview.String())
 
 	// Return to Redis configuration
 	return configureRedisWithEducation(config)
@@ -1423,7 +1625,7 @@ func configureRedisConnection(redisConfig *generator.RedisConfig) error {
 		Default: "localhost",
 		Help:    "Hostname or IP address of your Redis server",
 	}
-	if err := survey.AskOne(hostPrompt, &redisConfig.Host, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(hostPrompt, &redisConfig.Host, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1433,7 +1635,7 @@ func configureRedisConnection(redisConfig *generator.RedisConfig) error {
 		Default: "6379",
 		Help:    "Port number for your Redis server",
 	}
-	if err := survey.AskOne(portPrompt, &redisConfig.Port, survey.WithValidator(survey.Required)); err != nil {
+	if err := askOne(portPrompt, &redisConfig.Port, survey.WithValidator(survey.Required)); err != nil {
 		return err
 	}
 
@@ -1442,7 +1644,7 @@ func configureRedisConnection(redisConfig *generator.RedisConfig) error {
 		Message: "Redis password (leave empty if no password):",
 		Help:    "Redis AUTH password (optional)",
 	}
-	survey.AskOne(passwordPrompt, &redisConfig.Password)
+	askOne(passwordPrompt, &redisConfig.Password)
 
 	// Database number
 	redisConfig.Database = 0 // Default to database 0
@@ -1475,7 +1677,7 @@ func configureProjectFeatures(config *ProjectConfiguration) error {
 			Educational: "Learn input validation patterns and security",
 		},
 		{
-			Name:        "Structured Logging",
+			Name:        structuredLoggingFeature,
 			Description: "JSON-structured logging with different levels",
 			Educational: "Learn observability and debugging best practices",
 		},
@@ -1496,6 +1698,15 @@ func configureProjectFeatures(config *ProjectConfiguration) error {
 		},
 	}
 
+	// Tracing needs the OpenTelemetry modules, which minimal dependency mode leaves out
+	if config.Type == "api" && !config.MinimalDeps {
+		features = append(features, ProjectFeature{
+			Name:        distributedTracingFeature,
+			Description: "OpenTelemetry spans with trace_id/span_id in logs and response headers (needs Structured Logging)",
+			Educational: "Learn how one ID ties a request's trace, its log lines and the response a client saw together",
+		})
+	}
+
 	for _, feature := range features {
 		var include string
 		includePrompt := &survey.Select{
@@ -1508,7 +1719,7 @@ func configureProjectFeatures(config *ProjectConfiguration) error {
 			},
 		}
 
-		if err := survey.AskOne(includePrompt, &include); err != nil {
+		if err := askOne(includePrompt, &include); err != nil {
 			return err
 		}
 
@@ -1526,7 +1737,7 @@ func configureProjectFeatures(config *ProjectConfiguration) error {
 					"No - Skip this feature",
 				},
 			}
-			if err := survey.AskOne(includePrompt, &include); err != nil {
+			if err := askOne(includePrompt, &include); err != nil {
 				return err
 			}
 		}
@@ -1549,103 +1760,131 @@ func configureProjectFeatures(config *ProjectConfiguration) error {
 // visualizeProjectStructure shows the project structure that will be generated
 func visualizeProjectStructure(config *ProjectConfiguration) error {
 	clearScreen()
-	fmt.Println("🏗️  Step 6: Project Structure Visualization")
-	fmt.Printf("Here's the structure that will be generated for your %s project:\n\n", config.Type)
+	var preview strings.Builder
+	fmt.Fprintln(&preview, "🏗️  Step 6: Project Structure Visualization")
+	fmt.Fprintf(&preview, "Here's the structure that will be generated for your %s project:\n\n", config.Type)
 
 	switch config.Type {
 	case "api":
-		fmt.Println("📁 Project Structure:")
-		fmt.Println("```")
-		fmt.Printf("%s/\n", config.Name)
-		fmt.Println("├── cmd/")
-		fmt.Println("│   └── api/")
-		fmt.Println("│       └── main.go              # Application entry point")
-		fmt.Println("├── internal/")
-		fmt.Println("│   ├── api/                     # 🌐 Interface Layer")
-		fmt.Println("│   │   ├── handlers/            # HTTP request handlers")
-		fmt.Println("│   │   ├── middleware/          # Cross-cutting concerns")
-		fmt.Println("│   │   └── routes/              # Route definitions")
-		fmt.Println("│   ├── domain/                  # 🏛️  Domain Layer")
-		fmt.Println("│   │   ├── user/                # User business logic")
-		fmt.Println("│   │   └── post/                # Post business logic")
-		fmt.Println("│   ├── infrastructure/          # 🔧 Infrastructure Layer")
-		fmt.Println("│   │   ├── database/            # Database implementations")
-		fmt.Println("│   │   └── auth/                # Authentication services")
-		fmt.Println("│   ├── config/                  # Configuration management")
-		fmt.Println("│   └── pkg/                     # Shared utilities")
-		fmt.Println("├── migrations/                  # Database migrations")
-		fmt.Println("├── scripts/                     # Build and deployment scripts")
-		fmt.Println("├── .env.example                 # Environment variables template")
-		fmt.Println("├── go.mod                       # Go module definition")
-		fmt.Println("├── README.md                    # Project documentation")
-		fmt.Println("└── gophex.md                    # Project metadata")
-		fmt.Println("```")
-
-		fmt.Printf("\n🚀 Framework: %s\n", strings.ToUpper(config.Framework))
-		fmt.Printf("🗄️  Database: %s (%s)\n", strings.ToUpper(config.DatabaseConfig.Type), config.DatabaseConfig.ConfigType)
+		fmt.Fprintln(&preview, "📁 Project Structure:")
+		fmt.Fprintln(&preview, "```")
+		fmt.Fprintf(&preview, "%s/\n", config.Name)
+		fmt.Fprintln(&preview, "├── .github/workflows/ci.yml     # Tests and multi-arch image builds")
+		fmt.Fprintln(&preview, "├── cmd/")
+		fmt.Fprintln(&preview, "│   ├── api/")
+		fmt.Fprintln(&preview, "│   │   └── main.go              # Application entry point")
+		fmt.Fprintln(&preview, "│   └── healthcheck/")
+		fmt.Fprintln(&preview, "│       └── main.go              # Container health probe")
+		fmt.Fprintln(&preview, "├── internal/")
+		fmt.Fprintln(&preview, "│   ├── api/                     # 🌐 Interface Layer")
+		fmt.Fprintln(&preview, "│   │   ├── handlers/            # HTTP request handlers")
+		fmt.Fprintln(&preview, "│   │   ├── middleware/          # Cross-cutting concerns")
+		fmt.Fprintln(&preview, "│   │   └── routes/              # Route definitions")
+		fmt.Fprintln(&preview, "│   ├── domain/                  # 🏛️  Domain Layer")
+		fmt.Fprintln(&preview, "│   │   ├── user/                # User business logic")
+		fmt.Fprintln(&preview, "│   │   └── post/                # Post business logic")
+		fmt.Fprintln(&preview, "│   ├── infrastructure/          # 🔧 Infrastructure Layer")
+		fmt.Fprintln(&preview, "│   │   ├── database/            # Database implementations")
+		fmt.Fprintln(&preview, "│   │   └── auth/                # Authentication services")
+		fmt.Fprintln(&preview, "│   ├── config/                  # Configuration management")
+		fmt.Fprintln(&preview, "│   └── pkg/                     # Shared utilities")
+		fmt.Fprintln(&preview, "├── migrations/                  # Database migrations")
+		fmt.Fprintln(&preview, "├── scripts/                     # Build and deployment scripts")
+		fmt.Fprintln(&preview, "├── .env.example                 # Environment variables template")
+		fmt.Fprintln(&preview, "├── Dockerfile                   # Multi-stage, non-root container build")
+		fmt.Fprintln(&preview, "├── Makefile                     # Build, test and docker-buildx targets")
+		fmt.Fprintln(&preview, "├── go.mod                       # Go module definition")
+		fmt.Fprintln(&preview, "├── README.md                    # Project documentation")
+		fmt.Fprintln(&preview, "└── gophex.md                    # Project metadata")
+		fmt.Fprintln(&preview, "```")
+
+		if config.MinimalDeps {
+			fmt.Fprintln(&preview, "\n🚀 Framework: net/http (standard library)")
+		} else {
+			fmt.Fprintf(&preview, "\n🚀 Framework: %s\n", strings.ToUpper(config.Framework))
+		}
+		fmt.Fprintf(&preview, "🗄️  Database: %s (%s)\n", strings.ToUpper(config.DatabaseConfig.Type), config.DatabaseConfig.ConfigType)
 		if config.RedisConfig.Enabled {
-			fmt.Println("🚀 Caching: Redis enabled")
+			fmt.Fprintln(&preview, "🚀 Caching: Redis enabled")
+		}
+		if config.tracingEnabled() {
+			fmt.Fprintln(&preview, "🔭 Tracing: OpenTelemetry, correlated with request logs and response headers")
+		}
+		if config.DockerConfig != nil {
+			fmt.Fprintf(&preview, "🐳 Container: %s runtime image (%s)\n", config.DockerConfig.RuntimeImage, strings.Join(config.DockerConfig.Platforms, ", "))
 		}
 
 	case "webapp":
-		fmt.Println("📁 Project Structure:")
-		fmt.Println("```")
-		fmt.Printf("%s/\n", config.Name)
-		fmt.Println("├── cmd/")
-		fmt.Println("│   └── webapp/")
-		fmt.Println("│       └── main.go              # Application entry point")
-		fmt.Println("├── internal/")
-		fmt.Println("│   ├── handlers/                # HTTP handlers for pages")
-		fmt.Println("│   ├── models/                  # Data models")
-		fmt.Println("│   ├── middleware/              # Web middleware")
-		fmt.Println("│   └── config/                  # Configuration")
-		fmt.Println("├── web/")
-		fmt.Println("│   ├── templates/               # HTML templates")
-		fmt.Println("│   └── static/                  # CSS, JS, images")
-		fmt.Println("├── go.mod")
-		fmt.Println("├── README.md")
-		fmt.Println("└── gophex.md")
-		fmt.Println("```")
+		fmt.Fprintln(&preview, "📁 Project Structure:")
+		fmt.Fprintln(&preview, "```")
+		fmt.Fprintf(&preview, "%s/\n", config.Name)
+		fmt.Fprintln(&preview, "├── cmd/")
+		fmt.Fprintln(&preview, "│   └── webapp/")
+		fmt.Fprintln(&preview, "│       └── main.go              # Application entry point")
+		fmt.Fprintln(&preview, "├── internal/")
+		fmt.Fprintln(&preview, "│   ├── handlers/                # HTTP handlers for pages")
+		fmt.Fprintln(&preview, "│   ├── models/                  # Data models")
+		fmt.Fprintln(&preview, "│   ├── middleware/              # Web middleware")
+		fmt.Fprintln(&preview, "│   └── config/                  # Configuration")
+		fmt.Fprintln(&preview, "├── web/")
+		fmt.Fprintln(&preview, "│   ├── templates/               # HTML templates")
+		fmt.Fprintln(&preview, "│   └── static/                  # CSS, JS, images")
+		fmt.Fprintln(&preview, "├── go.mod")
+		fmt.Fprintln(&preview, "├── README.md")
+		fmt.Fprintln(&preview, "└── gophex.md")
+		fmt.Fprintln(&preview, "```")
 
 	case "cli":
-		fmt.Println("📁 Project Structure:")
-		fmt.Println("```")
-		fmt.Printf("%s/\n", config.Name)
-		fmt.Println("├── cmd/")
-		fmt.Println("│   └── main.go                  # CLI entry point")
-		fmt.Println("├── internal/")
-		fmt.Println("│   └── cmd/                     # Command implementations")
-		fmt.Println("│       ├── root.go              # Root command")
-		fmt.Println("│       └── version.go           # Version command")
-		fmt.Println("├── go.mod")
-		fmt.Println("├── README.md")
-		fmt.Println("└── gophex.md")
-		fmt.Println("```")
+		fmt.Fprintln(&preview, "📁 Project Structure:")
+		fmt.Fprintln(&preview, "```")
+		fmt.Fprintf(&preview, "%s/\n", config.Name)
+		fmt.Fprintln(&preview, "├── cmd/")
+		fmt.Fprintln(&preview, "│   └── main.go                  # CLI entry point")
+		fmt.Fprintln(&preview, "├── internal/")
+		fmt.Fprintln(&preview, "│   └── cmd/                     # Command implementations")
+		fmt.Fprintln(&preview, "│       ├── root.go              # Root command")
+		fmt.Fprintln(&preview, "│       └── version.go           # Version command")
+		fmt.Fprintln(&preview, "├── go.mod")
+		fmt.Fprintln(&preview, "├── README.md")
+		fmt.Fprintln(&preview, "└── gophex.md")
+		fmt.Fprintln(&preview, "```")
 
 	case "microservice":
-		fmt.Println("📁 Project Structure:")
-		fmt.Println("```")
-		fmt.Printf("%s/\n", config.Name)
-		fmt.Println("├── cmd/")
-		fmt.Println("│   └── server/")
-		fmt.Println("│       └── main.go              # Service entry point")
-		fmt.Println("├── internal/")
-		fmt.Println("│   ├── handlers/                # gRPC handlers")
-		fmt.Println("│   ├── config/                  # Configuration")
-		fmt.Println("│   └── health/                  # Health checks")
-		fmt.Println("├── proto/                       # Protocol buffer definitions")
-		fmt.Println("├── go.mod")
-		fmt.Println("├── README.md")
-		fmt.Println("└── gophex.md")
-		fmt.Println("```")
-	}
-
-	fmt.Println("\n🎓 Educational Features:")
-	fmt.Println("• Comprehensive code comments explaining patterns")
-	fmt.Println("• Clean Architecture principles demonstrated")
-	fmt.Println("• Best practices for Go development")
-	fmt.Println("• Example implementations and tests")
-	fmt.Println("• Step-by-step learning documentation")
+		fmt.Fprintln(&preview, "📁 Project Structure:")
+		fmt.Fprintln(&preview, "```")
+		fmt.Fprintf(&preview, "%s/\n", config.Name)
+		fmt.Fprintln(&preview, "├── cmd/")
+		fmt.Fprintln(&preview, "│   └── server/")
+		fmt.Fprintln(&preview, "│       └── main.go              # Service entry point")
+		fmt.Fprintln(&preview, "├── internal/")
+		fmt.Fprintln(&preview, "│   ├── handlers/                # gRPC handlers")
+		fmt.Fprintln(&preview, "│   ├── config/                  # Configuration")
+		fmt.Fprintln(&preview, "│   └── health/                  # Health checks")
+		fmt.Fprintln(&preview, "├── proto/                       # Protocol buffer definitions")
+		fmt.Fprintln(&preview, "├── go.mod")
+		fmt.Fprintln(&preview, "├── README.md")
+		fmt.Fprintln(&preview, "└── gophex.md")
+		fmt.Fprintln(&preview, "```")
+	}
+
+	if config.GoConfig != nil {
+		fmt.Fprintf(&preview, "\n🐹 Go: %s", config.GoConfig.Version)
+		if config.GoConfig.Toolchain != "" {
+			fmt.Fprintf(&preview, " (toolchain %s)", config.GoConfig.Toolchain)
+		}
+		fmt.Fprintln(&preview)
+	}
+	if config.MinimalDeps {
+		fmt.Fprintln(&preview, "📦 Dependencies: minimal (standard library wherever possible)")
+	}
+
+	fmt.Fprintln(&preview, "\n🎓 Educational Features:")
+	fmt.Fprintln(&preview, "• Comprehensive code comments explaining patterns")
+	fmt.Fprintln(&preview, "• Clean Architecture principles demonstrated")
+	fmt.Fprintln(&preview, "• Best practices for Go development")
+	fmt.Fprintln(&preview, "• Example implementations and tests")
+	fmt.Fprintln(&preview, "• Step-by-step learning documentation")
+	showPaged(preview.String())
 
 	var proceed string
 	proceedPrompt := &survey.Select{
@@ -1657,7 +1896,7 @@ func visualizeProjectStructure(config *ProjectConfiguration) error {
 		},
 	}
 
-	if err := survey.AskOne(proceedPrompt, &proceed); err != nil {
+	if err := askOne(proceedPrompt, &proceed); err != nil {
 		return err
 	}
 
@@ -1680,7 +1919,7 @@ func generateProjectWithExplanation(config *ProjectConfiguration) error {
 	fmt.Println()
 
 	// Generate the project
-	gen := generator.New()
+	gen := generator.New().WithDockerConfig(config.DockerConfig).WithGoConfig(config.GoConfig).WithMinimalDeps(config.MinimalDeps).WithTracing(config.tracingEnabled())
 	var err error
 	if config.Type == "api" {
 		err = gen.GenerateWithFramework(config.Type, config.Name, config.Path, config.Framework, config.DatabaseConfig, config.RedisConfig)
@@ -1697,6 +1936,8 @@ func generateProjectWithExplanation(config *ProjectConfiguration) error {
 	if err := tracker.CreateInitialMetadata(config.Type, config.Name, config.Path, config.DatabaseConfig, config.RedisConfig); err != nil {
 		fmt.Printf("⚠️  Warning: Failed to create project tracking metadata: %v\n", err)
 	}
+	registerProject(config.Name, config.Type, config.Path)
+	runPostGenerateHooks(config.Path)
 
 	fmt.Printf("✅ Successfully generated %s project '%s'!\n", config.Type, config.Name)
 	fmt.Printf("📍 Location: %s\n\n", config.Path)
@@ -1716,7 +1957,11 @@ func explainGeneratedProject(config *ProjectConfiguration) error {
 		fmt.Printf("• Domain entities (User, Post) with business rules\n")
 		fmt.Printf("• Repository interfaces and %s implementations\n", config.DatabaseConfig.Type)
 		fmt.Printf("• Service layer with use cases and business logic\n")
-		fmt.Printf("• %s HTTP handlers with proper error handling\n", strings.ToUpper(config.Framework))
+		if config.MinimalDeps {
+			fmt.Printf("• net/http handlers with proper error handling\n")
+		} else {
+			fmt.Printf("• %s HTTP handlers with proper error handling\n", strings.ToUpper(config.Framework))
+		}
 		fmt.Printf("• Middleware for logging, CORS, and authentication\n")
 		fmt.Printf("• Database migrations for %s\n", config.DatabaseConfig.Type)
 		if config.RedisConfig.Enabled {
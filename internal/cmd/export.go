@@ -0,0 +1,465 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/registry"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/utils"
+	"github.com/buildwithhp/gophex/pkg/version"
+)
+
+// exportUsage and importUsage describe the export and import subcommands
+const (
+	exportUsage = "gophex export --zip [--output FILE] [--path DIR] [--include-secrets]"
+	importUsage = "gophex import ARCHIVE [--dest DIR]"
+)
+
+// exportSpecFile is the archive entry describing the exported project
+const exportSpecFile = "gophex-export.json"
+
+// exportFormatVersion is bumped when the archive layout changes
+const exportFormatVersion = 1
+
+// excludedExportDirs are build artifacts, dependencies and local state left out of exports
+var excludedExportDirs = map[string]bool{
+	".git":         true,
+	".idea":        true,
+	".vscode":      true,
+	"bin":          true,
+	"dist":         true,
+	"tmp":          true,
+	"vendor":       true,
+	"node_modules": true,
+}
+
+// exportedEnvTemplate is the only .env file exported by default; the others hold credentials
+const exportedEnvTemplate = ".env.example"
+
+// excludedExportSuffixes are build and test output files left out of exports
+var excludedExportSuffixes = []string{".exe", ".test", ".out", ".prof", ".log"}
+
+// executableMagic are the leading bytes of compiled binaries (ELF, Mach-O and Windows PE)
+var executableMagic = [][]byte{
+	{0x7f, 'E', 'L', 'F'},
+	{0xfe, 0xed, 0xfa, 0xce},
+	{0xfe, 0xed, 0xfa, 0xcf},
+	{0xce, 0xfa, 0xed, 0xfe},
+	{0xcf, 0xfa, 0xed, 0xfe},
+	{'M', 'Z'},
+}
+
+// exportSpec describes an exported project. It is stored at the root of the archive,
+// next to a directory named after the project that holds the project files.
+type exportSpec struct {
+	FormatVersion int    `json:"format_version"`
+	Name          string `json:"name"`
+	Type          string `json:"type"`
+	ModulePath    string `json:"module_path,omitempty"`
+	DatabaseType  string `json:"database_type,omitempty"`
+	GeneratedWith string `json:"generated_with,omitempty"`
+	ExportedWith  string `json:"exported_with"`
+	ExportedAt    string `json:"exported_at"`
+	Files         int    `json:"files"`
+}
+
+// runExport implements "gophex export --zip [--output FILE] [--path DIR] [--include-secrets]"
+func runExport(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("export", flag.ContinueOnError)
+	asZip := fs.Bool("zip", false, "package the project as a zip archive")
+	output := fs.String("output", "", "archive to write (default: NAME.zip in the current directory)")
+	projectPath := fs.String("path", ".", "project directory containing gophex.md")
+	includeSecrets := fs.Bool("include-secrets", false, "also archive .env files, which hold database credentials and the JWT secret")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 0 {
+		return usageError(exportUsage)
+	}
+	if !*asZip {
+		return failure.User(fmt.Errorf("choose an archive format: only --zip is supported (usage: %s)", exportUsage), "")
+	}
+
+	if !utils.HasGophexMetadata(*projectPath) {
+		return failure.Environment(fmt.Errorf("%s is not a Gophex project: gophex.md not found", *projectPath), utils.ProjectDirHint)
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(*projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project metadata: %w", err)
+	}
+
+	spec := exportSpec{
+		FormatVersion: exportFormatVersion,
+		Name:          projectMetadata.Project.Name,
+		Type:          projectMetadata.Project.Type,
+		ModulePath:    projectMetadata.Project.ModulePath,
+		DatabaseType:  projectMetadata.Database.Type,
+		GeneratedWith: projectMetadata.Project.GophexVersion,
+		ExportedWith:  version.GetVersion(),
+		ExportedAt:    time.Now().Format(time.RFC3339),
+	}
+	if spec.Name == "" {
+		absPath, err := filepath.Abs(*projectPath)
+		if err != nil {
+			return fmt.Errorf("failed to resolve project path: %w", err)
+		}
+		spec.Name = filepath.Base(absPath)
+	}
+	if spec.ModulePath == "" {
+		if modulePath, err := utils.ReadModulePath(*projectPath); err == nil {
+			spec.ModulePath = modulePath
+		}
+	}
+
+	archivePath := *output
+	if archivePath == "" {
+		archivePath = spec.Name + ".zip"
+	}
+
+	files, secrets, err := collectExportFiles(*projectPath, archivePath, *includeSecrets)
+	if err != nil {
+		return err
+	}
+	spec.Files = len(files)
+
+	if err := writeExportArchive(archivePath, *projectPath, files, spec); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "✅ Exported %s (%d files) to %s\n", spec.Name, spec.Files, archivePath)
+	if len(secrets) > 0 {
+		if *includeSecrets {
+			fmt.Fprintf(out, "⚠️  The archive contains credentials from %s; share it only where they may go\n", strings.Join(secrets, ", "))
+		} else {
+			fmt.Fprintf(out, "🔒 Left out %s; pass --include-secrets to archive them\n", strings.Join(secrets, ", "))
+		}
+	}
+	fmt.Fprintf(out, "   Restore it with: gophex import %s\n", archivePath)
+	return nil
+}
+
+// collectExportFiles lists the project files to archive, relative to the project and
+// slash-separated. Build artifacts, dependencies, logs and the archive itself are skipped.
+// It also returns the .env files found, which are archived only with includeSecrets.
+func collectExportFiles(projectPath, archivePath string, includeSecrets bool) (files, secrets []string, err error) {
+	absArchive, err := filepath.Abs(archivePath)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to resolve archive path: %w", err)
+	}
+
+	err = filepath.WalkDir(projectPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(projectPath, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+
+		if d.IsDir() {
+			if rel != "." && (excludedExportDirs[d.Name()] || rel == ".gophex/logs") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.Type().IsRegular() {
+			return nil
+		}
+
+		if absPath, err := filepath.Abs(path); err == nil && absPath == absArchive {
+			return nil
+		}
+		if isSecretFile(d.Name()) {
+			secrets = append(secrets, rel)
+			if !includeSecrets {
+				return nil
+			}
+		}
+
+		excluded, err := isBuildArtifact(path, d.Name())
+		if err != nil {
+			return err
+		}
+		if !excluded {
+			files = append(files, rel)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to collect project files: %w", err)
+	}
+
+	return files, secrets, nil
+}
+
+// isSecretFile reports whether a file is a local environment file such as .env or .env.local
+func isSecretFile(name string) bool {
+	return strings.HasPrefix(name, ".env") && name != exportedEnvTemplate
+}
+
+// isBuildArtifact reports whether a file is build or test output, such as a compiled binary
+func isBuildArtifact(path, name string) (bool, error) {
+	for _, suffix := range excludedExportSuffixes {
+		if strings.HasSuffix(name, suffix) {
+			return true, nil
+		}
+	}
+	if strings.HasPrefix(name, "coverage.") {
+		return true, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+
+	header := make([]byte, 4)
+	n, _ := io.ReadFull(file, header)
+	for _, magic := range executableMagic {
+		if n >= len(magic) && bytes.Equal(header[:len(magic)], magic) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// writeExportArchive writes the spec and the project files to a zip archive
+func writeExportArchive(archivePath, projectPath string, files []string, spec exportSpec) (err error) {
+	archive, err := os.Create(archivePath)
+	if err != nil {
+		return fmt.Errorf("failed to create archive: %w", err)
+	}
+	defer func() {
+		if closeErr := archive.Close(); err == nil && closeErr != nil {
+			err = fmt.Errorf("failed to write archive: %w", closeErr)
+		}
+		if err != nil {
+			os.Remove(archivePath)
+		}
+	}()
+
+	writer := zip.NewWriter(archive)
+
+	specData, err := json.MarshalIndent(spec, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal export spec: %w", err)
+	}
+	entry, err := writer.Create(exportSpecFile)
+	if err != nil {
+		return fmt.Errorf("failed to write export spec: %w", err)
+	}
+	if _, err := entry.Write(specData); err != nil {
+		return fmt.Errorf("failed to write export spec: %w", err)
+	}
+
+	for _, rel := range files {
+		if err := addArchiveFile(writer, filepath.Join(projectPath, filepath.FromSlash(rel)), path.Join(spec.Name, rel)); err != nil {
+			return err
+		}
+	}
+
+	if err := writer.Close(); err != nil {
+		return fmt.Errorf("failed to write archive: %w", err)
+	}
+	return nil
+}
+
+// addArchiveFile copies one file into the archive, keeping its permissions and modification time
+func addArchiveFile(writer *zip.Writer, filePath, name string) error {
+	info, err := os.Stat(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to stat %s: %w", filePath, err)
+	}
+
+	header, err := zip.FileInfoHeader(info)
+	if err != nil {
+		return fmt.Errorf("failed to archive %s: %w", filePath, err)
+	}
+	header.Name = name
+	header.Method = zip.Deflate
+
+	entry, err := writer.CreateHeader(header)
+	if err != nil {
+		return fmt.Errorf("failed to archive %s: %w", filePath, err)
+	}
+
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", filePath, err)
+	}
+	defer file.Close()
+
+	if _, err := io.Copy(entry, file); err != nil {
+		return fmt.Errorf("failed to archive %s: %w", filePath, err)
+	}
+	return nil
+}
+
+// runImport implements "gophex import ARCHIVE [--dest DIR]"
+func runImport(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("import", flag.ContinueOnError)
+	dest := fs.String("dest", "", "directory to restore the project into (default: ./NAME)")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) != 1 {
+		return usageError(importUsage)
+	}
+
+	reader, err := zip.OpenReader(positional[0])
+	if err != nil {
+		return fmt.Errorf("failed to open archive: %w", err)
+	}
+	defer reader.Close()
+
+	spec, err := readExportSpec(&reader.Reader)
+	if err != nil {
+		return err
+	}
+
+	projectPath := *dest
+	if projectPath == "" {
+		projectPath = spec.Name
+	}
+	if err := ensureEmptyDir(projectPath); err != nil {
+		return err
+	}
+
+	restored, err := extractProject(&reader.Reader, spec.Name, projectPath)
+	if err != nil {
+		return err
+	}
+
+	if err := registry.Register(spec.Name, spec.Type, projectPath); err != nil {
+		return fmt.Errorf("project restored but could not be registered: %w", err)
+	}
+
+	fmt.Fprintf(out, "✅ Imported %s (%d files) into %s\n", spec.Name, restored, projectPath)
+	if spec.GeneratedWith != "" && spec.GeneratedWith != version.GetVersion() {
+		fmt.Fprintf(out, "ℹ️  Generated with Gophex %s; this is Gophex %s\n", spec.GeneratedWith, version.GetVersion())
+	}
+	fmt.Fprintln(out, "   Registered for gophex dashboard")
+	return nil
+}
+
+// readExportSpec reads and validates the spec of an export archive
+func readExportSpec(reader *zip.Reader) (*exportSpec, error) {
+	file, err := reader.Open(exportSpecFile)
+	if err != nil {
+		return nil, failure.User(fmt.Errorf("not a Gophex export: %s not found in archive", exportSpecFile), `create archives with "gophex export --zip"`)
+	}
+	defer file.Close()
+
+	var spec exportSpec
+	if err := json.NewDecoder(file).Decode(&spec); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", exportSpecFile, err)
+	}
+
+	if spec.FormatVersion > exportFormatVersion {
+		return nil, failure.Environment(fmt.Errorf("archive format v%d is newer than this Gophex supports (v%d): upgrade Gophex to import it",
+			spec.FormatVersion, exportFormatVersion), "go install github.com/buildwithhp/gophex@latest")
+	}
+	if spec.Name == "" || spec.Name == "." || spec.Name == ".." || strings.ContainsAny(spec.Name, `/\`) {
+		return nil, fmt.Errorf("invalid project name %q in %s", spec.Name, exportSpecFile)
+	}
+
+	return &spec, nil
+}
+
+// ensureEmptyDir creates dir, refusing to restore over an existing non-empty directory
+func ensureEmptyDir(dir string) error {
+	entries, err := os.ReadDir(dir)
+	if err == nil && len(entries) > 0 {
+		return failure.User(fmt.Errorf("%s already exists and is not empty: choose another location with --dest", dir), "")
+	}
+	if err != nil && !os.IsNotExist(err) {
+		return fmt.Errorf("failed to read %s: %w", dir, err)
+	}
+
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", dir, err)
+	}
+	return nil
+}
+
+// extractProject restores the files under the archive's project directory into projectPath.
+// Every entry is checked before anything is written, so an archive with entries that would
+// land outside projectPath is rejected as a whole.
+func extractProject(reader *zip.Reader, name, projectPath string) (int, error) {
+	prefix := name + "/"
+	targets := make(map[*zip.File]string)
+
+	for _, file := range reader.File {
+		if file.Name == exportSpecFile || file.FileInfo().IsDir() {
+			continue
+		}
+
+		rel := strings.TrimPrefix(file.Name, prefix)
+		if rel == file.Name || !fs.ValidPath(rel) || strings.Contains(rel, `\`) {
+			return 0, fmt.Errorf("refusing to extract %q: outside the project directory", file.Name)
+		}
+		if file.Mode().IsRegular() {
+			targets[file] = filepath.Join(projectPath, filepath.FromSlash(rel))
+		}
+	}
+
+	for _, file := range reader.File {
+		target, ok := targets[file]
+		if !ok {
+			continue
+		}
+		if err := extractFile(file, target); err != nil {
+			return 0, err
+		}
+	}
+
+	return len(targets), nil
+}
+
+// extractFile writes one archive entry to target
+func extractFile(file *zip.File, target string) error {
+	if err := os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+		return fmt.Errorf("failed to create %s: %w", filepath.Dir(target), err)
+	}
+
+	src, err := file.Open()
+	if err != nil {
+		return fmt.Errorf("failed to read %s from archive: %w", file.Name, err)
+	}
+	defer src.Close()
+
+	dst, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, file.Mode().Perm()|0600)
+	if err != nil {
+		return fmt.Errorf("failed to create %s: %w", target, err)
+	}
+
+	if _, err := io.Copy(dst, src); err != nil {
+		dst.Close()
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+	if err := dst.Close(); err != nil {
+		return fmt.Errorf("failed to write %s: %w", target, err)
+	}
+
+	return os.Chtimes(target, file.Modified, file.Modified)
+}
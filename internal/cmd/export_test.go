@@ -0,0 +1,191 @@
+package cmd
+
+import (
+	"archive/zip"
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/registry"
+	"github.com/buildwithhp/gophex/internal/testutil"
+)
+
+// writeExportProject creates a project with source files, metadata and build artifacts
+func writeExportProject(t *testing.T) string {
+	t.Helper()
+	projectMetadata := &metadata.ProjectMetadata{
+		Project: metadata.ProjectInfo{Name: "shop", Type: "api", GophexVersion: "1.0.0"},
+	}
+
+	return testutil.WriteProject(t, projectMetadata, map[string]string{
+		"go.mod":                    "module shop\n\ngo 1.22\n",
+		"cmd/api/main.go":           "package main\n\nfunc main() {}\n",
+		".gophex/packs/retail.json": `{"name": "retail", "presets": []}`,
+		".gophex/logs/api.log":      "started\n",
+		".git/HEAD":                 "ref: refs/heads/main\n",
+		"bin/shop":                  "\x7fELFbinary",
+		"shop":                      "\x7fELFbinary",
+		"coverage.out":              "mode: set\n",
+		".env":                      "JWT_SECRET=s3cret\n",
+		".env.local":                "DB_PASSWORD=s3cret\n",
+		".env.example":              "JWT_SECRET=change-me\n",
+	})
+}
+
+func TestExportImportRoundTrip(t *testing.T) {
+	t.Setenv("GOPHEX_HOME", t.TempDir())
+	projectPath := writeExportProject(t)
+	archivePath := filepath.Join(projectPath, "shop.zip")
+
+	var out bytes.Buffer
+	if err := runExport([]string{"--zip", "--path", projectPath, "--output", archivePath}, &out); err != nil {
+		t.Fatalf("Unexpected export error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Exported shop (5 files)") {
+		t.Errorf("Expected 5 exported files, got %q", out.String())
+	}
+	if !strings.Contains(out.String(), "Left out .env, .env.local") {
+		t.Errorf("Expected the skipped .env files to be reported, got %q", out.String())
+	}
+
+	dest := filepath.Join(t.TempDir(), "restored")
+	out.Reset()
+	if err := runImport([]string{archivePath, "--dest", dest}, &out); err != nil {
+		t.Fatalf("Unexpected import error: %v", err)
+	}
+
+	for _, name := range []string{"go.mod", "gophex.md", "cmd/api/main.go", ".gophex/packs/retail.json", ".env.example"} {
+		if _, err := os.Stat(filepath.Join(dest, name)); err != nil {
+			t.Errorf("Expected %s to be restored, got %v", name, err)
+		}
+	}
+	for _, name := range []string{".gophex/logs/api.log", ".git/HEAD", "bin/shop", "shop", "coverage.out", "shop.zip", ".env", ".env.local"} {
+		if _, err := os.Stat(filepath.Join(dest, name)); !os.IsNotExist(err) {
+			t.Errorf("Expected %s to be excluded from the export", name)
+		}
+	}
+
+	projects, err := registry.List()
+	if err != nil {
+		t.Fatalf("Failed to list registry: %v", err)
+	}
+	if len(projects) != 1 || projects[0].Name != "shop" || projects[0].Type != "api" {
+		t.Fatalf("Expected shop to be registered, got %+v", projects)
+	}
+	if absDest, _ := filepath.Abs(dest); projects[0].Path != absDest {
+		t.Errorf("Expected registered path %s, got %s", absDest, projects[0].Path)
+	}
+
+	if err := runImport([]string{archivePath, "--dest", dest}, &out); err == nil {
+		t.Error("Expected importing into a non-empty directory to fail")
+	}
+}
+
+func TestExportIncludeSecrets(t *testing.T) {
+	projectPath := writeExportProject(t)
+	archivePath := filepath.Join(t.TempDir(), "shop.zip")
+
+	var out bytes.Buffer
+	if err := runExport([]string{"--zip", "--include-secrets", "--path", projectPath, "--output", archivePath}, &out); err != nil {
+		t.Fatalf("Unexpected export error: %v", err)
+	}
+	if !strings.Contains(out.String(), "Exported shop (7 files)") {
+		t.Errorf("Expected 7 exported files, got %q", out.String())
+	}
+	if !strings.Contains(out.String(), "contains credentials from .env, .env.local") {
+		t.Errorf("Expected a warning about the archived credentials, got %q", out.String())
+	}
+
+	reader, err := zip.OpenReader(archivePath)
+	if err != nil {
+		t.Fatalf("Failed to open archive: %v", err)
+	}
+	defer reader.Close()
+
+	archived := make(map[string]bool)
+	for _, file := range reader.File {
+		archived[file.Name] = true
+	}
+	for _, name := range []string{"shop/.env", "shop/.env.local", "shop/.env.example"} {
+		if !archived[name] {
+			t.Errorf("Expected %s in the archive", name)
+		}
+	}
+}
+
+func TestExportErrors(t *testing.T) {
+	projectPath := writeExportProject(t)
+
+	if err := runExport([]string{"--path", projectPath}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "--zip") {
+		t.Errorf("Expected error asking for --zip, got %v", err)
+	}
+	if err := runExport([]string{"--zip", "--path", t.TempDir()}, &bytes.Buffer{}); err == nil || !strings.Contains(err.Error(), "gophex.md not found") {
+		t.Errorf("Expected error for a directory without gophex.md, got %v", err)
+	}
+}
+
+func TestImportRejectsUnsafeArchives(t *testing.T) {
+	t.Setenv("GOPHEX_HOME", t.TempDir())
+
+	tests := []struct {
+		name    string
+		entries map[string]string
+		wantErr string
+	}{
+		{
+			name:    "missing spec",
+			entries: map[string]string{"shop/go.mod": "module shop\n"},
+			wantErr: "not a Gophex export",
+		},
+		{
+			name: "path traversal",
+			entries: map[string]string{
+				exportSpecFile:      `{"format_version": 1, "name": "shop", "type": "api"}`,
+				"shop/../escape.go": "package escape\n",
+			},
+			wantErr: "refusing to extract",
+		},
+		{
+			name:    "unsafe project name",
+			entries: map[string]string{exportSpecFile: `{"format_version": 1, "name": "../shop", "type": "api"}`},
+			wantErr: "invalid project name",
+		},
+		{
+			name:    "newer format",
+			entries: map[string]string{exportSpecFile: `{"format_version": 99, "name": "shop", "type": "api"}`},
+			wantErr: "upgrade Gophex",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+			archivePath := filepath.Join(dir, "shop.zip")
+
+			var buf bytes.Buffer
+			writer := zip.NewWriter(&buf)
+			for name, content := range tt.entries {
+				entry, err := writer.Create(name)
+				if err != nil {
+					t.Fatalf("Failed to create entry: %v", err)
+				}
+				entry.Write([]byte(content))
+			}
+			writer.Close()
+			if err := os.WriteFile(archivePath, buf.Bytes(), 0644); err != nil {
+				t.Fatalf("Failed to write archive: %v", err)
+			}
+
+			err := runImport([]string{archivePath, "--dest", filepath.Join(dir, "out")}, &bytes.Buffer{})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if _, err := os.Stat(filepath.Join(dir, "escape.go")); !os.IsNotExist(err) {
+				t.Error("Expected no file to be written outside the destination")
+			}
+		})
+	}
+}
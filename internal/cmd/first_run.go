@@ -0,0 +1,505 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+// firstRunActivity records that the guided first run reached its last step
+const firstRunActivity = "first_run_completed"
+
+// databaseWaitTimeout bounds how long the first run waits for a database it started
+const databaseWaitTimeout = 30 * time.Second
+
+// firstRunStep is one step of the guided first run. Activity is recorded when the step succeeds;
+// steps whose activity is already completed are skipped unless the user chooses to repeat them.
+type firstRunStep struct {
+	Title    string
+	Activity string
+	Run      func(projectPath, projectType string) error
+}
+
+// localDatabase describes how to run a project's database in a local Docker container
+type localDatabase struct {
+	Image         string
+	ContainerPort string
+	Env           []string
+}
+
+// RunFirstRun walks a freshly generated project through setup in the order that works:
+// environment, database, migrations, dependencies, start, smoke tests and documentation.
+// Progress is recorded as activities, so a first run that stops can be resumed later.
+func RunFirstRun(projectPath, projectType string) error {
+	tracker := NewProjectTracker(projectPath)
+	if err := tracker.LoadMetadata(); err != nil {
+		fmt.Printf("⚠️  Warning: Could not load project metadata: %v\n", err)
+	}
+
+	steps := firstRunSteps(projectPath, projectType, tracker.GetMetadata())
+
+	fmt.Println("🧭 First Run - guided setup")
+	fmt.Println("   Each step runs in order; completed steps are skipped.")
+	fmt.Println()
+	for i, step := range steps {
+		status := "⬜"
+		if tracker.IsActivityCompleted(step.Activity) {
+			status = "✅"
+		}
+		fmt.Printf("   %s %d. %s\n", status, i+1, step.Title)
+	}
+	fmt.Println()
+
+	start := nextFirstRunStep(steps, tracker)
+	if start == len(steps) {
+		var choice string
+		repeatPrompt := &survey.Select{
+			Message: "Every first run step is complete. Run them all again?",
+			Options: []string{"Yes - Run every step again", "No - Back to the menu"},
+		}
+		if err := askOne(repeatPrompt, &choice); err != nil {
+			if isUserInterrupt(err) {
+				return nil
+			}
+			return err
+		}
+		if !strings.HasPrefix(choice, "Yes") {
+			return nil
+		}
+		start = 0
+	}
+
+	skipped, paused, err := runFirstRunSteps(projectPath, projectType, steps, start, tracker, askFirstRunRecovery)
+	if err != nil || paused {
+		return err
+	}
+
+	if len(skipped) > 0 {
+		fmt.Printf("\n⚠️  First run finished with %d skipped step(s):\n", len(skipped))
+		for _, title := range skipped {
+			fmt.Printf("   ⏭️  %s\n", title)
+		}
+		fmt.Println("   Choose \"First run\" from the menu to run them again.")
+		return nil
+	}
+
+	recordFirstRunActivity(tracker, firstRunActivity)
+	fmt.Println("\n🎉 First run complete! Your project is set up and running.")
+	return nil
+}
+
+// runFirstRunSteps runs steps from start, asking askRecovery what to do when one fails. It returns
+// the titles of the steps the user skipped, and whether the user paused the first run.
+func runFirstRunSteps(projectPath, projectType string, steps []firstRunStep, start int, tracker *ProjectTracker, askRecovery func() (string, error)) ([]string, bool, error) {
+	var skipped []string
+	for i := start; i < len(steps); i++ {
+		step := steps[i]
+		if i > start && tracker.IsActivityCompleted(step.Activity) {
+			fmt.Printf("\n✅ Step %d/%d: %s (already done)\n", i+1, len(steps), step.Title)
+			continue
+		}
+
+		fmt.Printf("\n➡️  Step %d/%d: %s\n", i+1, len(steps), step.Title)
+		for {
+			err := step.Run(projectPath, projectType)
+			if err == nil {
+				recordFirstRunActivity(tracker, step.Activity)
+				if step.Activity == "database_migrated" {
+					if err := tracker.UpdateDatabaseStatus(true, true); err != nil {
+						fmt.Printf("⚠️  Warning: Could not record the database status in gophex.md: %v\n", err)
+					}
+				}
+				break
+			}
+
+			fmt.Printf("❌ %s failed: %v\n", step.Title, err)
+			action, askErr := askRecovery()
+			if askErr != nil {
+				return skipped, false, askErr
+			}
+			if action == "retry" {
+				continue
+			}
+			if action == "stop" {
+				fmt.Println("\n⏸️  First run paused. Choose \"First run\" from the menu to resume from this step.")
+				return skipped, true, nil
+			}
+			fmt.Printf("⏭️  Skipped: %s\n", step.Title)
+			skipped = append(skipped, step.Title)
+			break
+		}
+	}
+	return skipped, false, nil
+}
+
+// recordFirstRunActivity records a completed activity, warning when gophex.md cannot be updated
+func recordFirstRunActivity(tracker *ProjectTracker, activity string) {
+	if err := tracker.UpdateActivity(activity, true); err != nil {
+		fmt.Printf("⚠️  Warning: Could not record %s in gophex.md: %v\n", activity, err)
+	}
+}
+
+// firstRunSteps lists the steps that apply to a project, in the order they must run
+func firstRunSteps(projectPath, projectType string, projectMetadata *metadata.ProjectMetadata) []firstRunStep {
+	var steps []firstRunStep
+
+	if fileExists(filepath.Join(projectPath, ".env")) || fileExists(filepath.Join(projectPath, ".env.example")) {
+		steps = append(steps, firstRunStep{"Set up environment (.env)", "environment_configured", setupEnvironment})
+	}
+
+	if projectType == "api" && projectMetadata.Database.Configured {
+		steps = append(steps,
+			firstRunStep{"Provision the database", "database_provisioned", provisionDatabase},
+			firstRunStep{"Run database migrations/initialization", "database_migrated", RunDatabaseSetup},
+		)
+	}
+
+	steps = append(steps, firstRunStep{"Install dependencies (go mod tidy)", "dependencies_installed", func(projectPath, _ string) error {
+		return InstallDependencies(projectPath)
+	}})
+
+	if projectType != "cli" {
+		steps = append(steps, firstRunStep{"Start the application", "application_started", startForFirstRun})
+	}
+
+	if projectType == "api" && fileExists(filepath.Join(projectPath, "scripts", "smoke.go")) {
+		steps = append(steps, firstRunStep{"Run smoke tests", "smoke_tests_run", RunSmokeTests})
+	}
+
+	if fileExists(filepath.Join(projectPath, "README.md")) {
+		steps = append(steps, firstRunStep{"Open the project documentation", "documentation_viewed", func(projectPath, _ string) error {
+			return ViewDocumentation(projectPath)
+		}})
+	}
+
+	return steps
+}
+
+// nextFirstRunStep returns the index of the first step that has not been completed
+func nextFirstRunStep(steps []firstRunStep, tracker *ProjectTracker) int {
+	for i, step := range steps {
+		if !tracker.IsActivityCompleted(step.Activity) {
+			return i
+		}
+	}
+	return len(steps)
+}
+
+// askFirstRunRecovery asks what to do after a step failed: "retry", "skip" or "stop"
+func askFirstRunRecovery() (string, error) {
+	var choice string
+	recoveryPrompt := &survey.Select{
+		Message: "What would you like to do?",
+		Options: []string{
+			"Retry - Run this step again",
+			"Skip - Continue with the next step",
+			"Stop - Pause the first run and return to the menu",
+		},
+	}
+
+	if err := askOne(recoveryPrompt, &choice); err != nil {
+		if isUserInterrupt(err) {
+			return "stop", nil
+		}
+		return "", err
+	}
+	return strings.ToLower(strings.SplitN(choice, " ", 2)[0]), nil
+}
+
+// setupEnvironment creates .env from .env.example when it is missing and points out
+// values that still need attention
+func setupEnvironment(projectPath, _ string) error {
+	created, err := ensureEnvFile(projectPath)
+	if err != nil {
+		return err
+	}
+	if created {
+		fmt.Println("📝 Created .env from .env.example")
+	} else {
+		fmt.Println("✅ .env is present")
+	}
+
+	env, err := readEnvFile(filepath.Join(projectPath, ".env"))
+	if err != nil {
+		return err
+	}
+	if strings.Contains(env["JWT_SECRET"], "change-in-production") {
+		fmt.Println("   💡 JWT_SECRET is a development placeholder; set a random secret before deploying")
+	}
+	return nil
+}
+
+// ensureEnvFile copies .env.example to .env when .env does not exist yet
+func ensureEnvFile(projectPath string) (bool, error) {
+	envPath := filepath.Join(projectPath, ".env")
+	if fileExists(envPath) {
+		return false, nil
+	}
+
+	example, err := os.ReadFile(filepath.Join(projectPath, ".env.example"))
+	if err != nil {
+		return false, fmt.Errorf("neither .env nor .env.example found: %w", err)
+	}
+	if err := os.WriteFile(envPath, example, 0600); err != nil {
+		return false, fmt.Errorf("failed to write .env: %w", err)
+	}
+	return true, nil
+}
+
+// readEnvFile parses KEY=VALUE lines, ignoring comments and blank lines
+func readEnvFile(path string) (map[string]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", filepath.Base(path), err)
+	}
+	defer file.Close()
+
+	env := make(map[string]string)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if key, value, ok := strings.Cut(line, "="); ok {
+			env[strings.TrimSpace(key)] = strings.Trim(strings.TrimSpace(value), `"'`)
+		}
+	}
+	return env, scanner.Err()
+}
+
+// provisionDatabase makes sure the database in .env accepts connections. A local database
+// that is not running can be started in Docker; a remote one has to be started by the user.
+func provisionDatabase(projectPath, _ string) error {
+	dbType, err := getDatabaseTypeFromMetadata(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to determine database type: %w", err)
+	}
+
+	env, err := readEnvFile(filepath.Join(projectPath, ".env"))
+	if err != nil {
+		return err
+	}
+	databaseURL := firstNonEmpty(env["DATABASE_URL"], env["DATABASE_WRITE_URL"], strings.Split(env["DATABASE_CLUSTER_URLS"], ",")[0])
+	if databaseURL == "" {
+		return fmt.Errorf("no DATABASE_URL in .env")
+	}
+
+	creds, err := parseDatabaseURL(dbType, databaseURL)
+	if err != nil {
+		return err
+	}
+	address := net.JoinHostPort(creds.Host, creds.Port)
+
+	if databaseReachable(address) {
+		fmt.Printf("✅ %s is accepting connections at %s\n", dbType, address)
+		return nil
+	}
+
+	fmt.Printf("⚠️  No %s database is listening at %s\n", dbType, address)
+	if !isLocalHost(creds.Host) {
+		return failure.Environment(fmt.Errorf("start the database at %s, then retry this step", address), "")
+	}
+
+	database, ok := localDatabaseFor(dbType, creds)
+	if !ok {
+		return fmt.Errorf("cannot start a local %s database automatically; start it, then retry this step", dbType)
+	}
+	if _, err := exec.LookPath("docker"); err != nil {
+		fmt.Println("   💡 Start one with Docker:")
+		fmt.Printf("      docker %s\n", strings.Join(dockerRunArgs(projectPath, dbType, creds, database), " "))
+		return failure.Tool(fmt.Errorf("docker is not installed; start the database, then retry this step"), "")
+	}
+
+	var choice string
+	startPrompt := &survey.Select{
+		Message: fmt.Sprintf("Start %s in a Docker container (%s)?", dbType, database.Image),
+		Options: []string{
+			"Yes - Start a local database container",
+			"No - I'll start the database myself",
+		},
+	}
+	if err := askOne(startPrompt, &choice); err != nil {
+		return err
+	}
+	if !strings.HasPrefix(choice, "Yes") {
+		return failure.Environment(fmt.Errorf("start the database at %s, then retry this step", address), "")
+	}
+
+	name := databaseContainerName(projectPath, dbType)
+	// Reuse a container left by an earlier first run before creating a new one
+	if exec.Command("docker", "start", name).Run() != nil {
+		cmd := exec.Command("docker", dockerRunArgs(projectPath, dbType, creds, database)...)
+		cmd.Stdout = os.Stdout
+		cmd.Stderr = os.Stderr
+		if err := cmd.Run(); err != nil {
+			return fmt.Errorf("failed to start the database container: %w", err)
+		}
+	}
+
+	fmt.Printf("⏳ Waiting for %s at %s...\n", dbType, address)
+	deadline := time.Now().Add(databaseWaitTimeout)
+	for time.Now().Before(deadline) {
+		if databaseReachable(address) {
+			fmt.Printf("✅ %s is running in container %s\n", dbType, name)
+			return nil
+		}
+		time.Sleep(time.Second)
+	}
+	return fmt.Errorf("%s did not accept connections within %s", dbType, databaseWaitTimeout)
+}
+
+// databaseCredentials are the parts of a database URL needed to provision the database
+type databaseCredentials struct {
+	Host     string
+	Port     string
+	User     string
+	Password string
+	Database string
+}
+
+// parseDatabaseURL reads a DATABASE_URL in the format the generated .env uses for dbType
+func parseDatabaseURL(dbType, databaseURL string) (*databaseCredentials, error) {
+	if dbType == "mysql" {
+		// user:password@tcp(host:port)/database?params
+		userInfo, rest, ok := strings.Cut(databaseURL, "@tcp(")
+		address, path, ok2 := strings.Cut(rest, ")/")
+		if !ok || !ok2 {
+			return nil, fmt.Errorf("unrecognized MySQL DATABASE_URL")
+		}
+		user, password, _ := strings.Cut(userInfo, ":")
+		host, port, err := net.SplitHostPort(address)
+		if err != nil {
+			return nil, fmt.Errorf("unrecognized MySQL address %q: %w", address, err)
+		}
+		database, _, _ := strings.Cut(path, "?")
+		return &databaseCredentials{Host: host, Port: port, User: user, Password: password, Database: database}, nil
+	}
+
+	parsed, err := url.Parse(databaseURL)
+	if err != nil {
+		return nil, fmt.Errorf("unrecognized DATABASE_URL: %w", err)
+	}
+	host, port := parsed.Hostname(), parsed.Port()
+	if first, _, cluster := strings.Cut(parsed.Host, ","); cluster {
+		// MongoDB cluster URLs list several hosts; provision against the first
+		host, port, _ = strings.Cut(first, ":")
+	}
+	if port == "" {
+		port = map[string]string{"postgresql": "5432", "mongodb": "27017"}[dbType]
+	}
+	password, _ := parsed.User.Password()
+	return &databaseCredentials{
+		Host:     host,
+		Port:     port,
+		User:     parsed.User.Username(),
+		Password: password,
+		Database: strings.TrimPrefix(parsed.Path, "/"),
+	}, nil
+}
+
+// localDatabaseFor returns the Docker image and settings for a local database
+func localDatabaseFor(dbType string, creds *databaseCredentials) (localDatabase, bool) {
+	switch dbType {
+	case "postgresql":
+		return localDatabase{
+			Image:         "postgres:16",
+			ContainerPort: "5432",
+			Env:           []string{"POSTGRES_USER=" + creds.User, "POSTGRES_PASSWORD=" + creds.Password, "POSTGRES_DB=" + creds.Database},
+		}, true
+	case "mysql":
+		env := []string{"MYSQL_ROOT_PASSWORD=" + creds.Password, "MYSQL_DATABASE=" + creds.Database}
+		if creds.User != "root" {
+			env = append(env, "MYSQL_USER="+creds.User, "MYSQL_PASSWORD="+creds.Password)
+		}
+		return localDatabase{Image: "mysql:8", ContainerPort: "3306", Env: env}, true
+	case "mongodb":
+		return localDatabase{
+			Image:         "mongo:7",
+			ContainerPort: "27017",
+			Env:           []string{"MONGO_INITDB_ROOT_USERNAME=" + creds.User, "MONGO_INITDB_ROOT_PASSWORD=" + creds.Password, "MONGO_INITDB_DATABASE=" + creds.Database},
+		}, true
+	default:
+		return localDatabase{}, false
+	}
+}
+
+// dockerRunArgs returns the docker arguments that start the project's database container.
+// The port is published on loopback only, since the container uses development credentials.
+func dockerRunArgs(projectPath, dbType string, creds *databaseCredentials, database localDatabase) []string {
+	bindHost := "127.0.0.1"
+	if ip := net.ParseIP(creds.Host); ip != nil && ip.IsLoopback() {
+		bindHost = creds.Host
+	}
+
+	publish := net.JoinHostPort(bindHost, creds.Port) + ":" + database.ContainerPort
+	args := []string{"run", "-d", "--name", databaseContainerName(projectPath, dbType), "-p", publish}
+	for _, env := range database.Env {
+		args = append(args, "-e", env)
+	}
+	return append(args, database.Image)
+}
+
+// databaseContainerName names the project's database container
+func databaseContainerName(projectPath, dbType string) string {
+	name := filepath.Base(projectPath)
+	if absPath, err := filepath.Abs(projectPath); err == nil {
+		name = filepath.Base(absPath)
+	}
+	return fmt.Sprintf("gophex-%s-%s", strings.ToLower(name), dbType)
+}
+
+// databaseReachable reports whether something accepts TCP connections at address
+func databaseReachable(address string) bool {
+	conn, err := net.DialTimeout("tcp", address, 2*time.Second)
+	if err != nil {
+		return false
+	}
+	conn.Close()
+	return true
+}
+
+// isLocalHost reports whether host refers to this machine
+func isLocalHost(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+// startForFirstRun starts the application unless Gophex is already running it
+func startForFirstRun(projectPath, projectType string) error {
+	if logs := GetProcessManager().LogsFor(projectPath); logs != nil && logs.Running() {
+		fmt.Println("✅ The application is already running")
+		return nil
+	}
+	return StartApplication(projectPath, projectType)
+}
+
+// firstNonEmpty returns the first non-empty value
+func firstNonEmpty(values ...string) string {
+	for _, value := range values {
+		if value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// fileExists reports whether path exists
+func fileExists(path string) bool {
+	_, err := os.Stat(path)
+	return err == nil
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/buildwithhp/gophex/internal/generator"
+	"github.com/buildwithhp/gophex/internal/metadata"
 	"github.com/buildwithhp/gophex/internal/utils"
 )
 
@@ -347,6 +348,58 @@ func TestMetadataManagement(t *testing.T) {
 	}
 }
 
+// TestProjectTrackerUsesGeneratedMetadata tests that the tracker keeps gophex.md as the single metadata store
+func TestProjectTrackerUsesGeneratedMetadata(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gophex-tracker-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp dir: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "tracked")
+	dbConfig := &generator.DatabaseConfig{Type: "mysql", ConfigType: "single"}
+	if err := generator.New().GenerateWithConfig("api", "tracked", projectPath, dbConfig); err != nil {
+		t.Fatalf("Failed to generate project: %v", err)
+	}
+
+	tracker := NewProjectTracker(projectPath)
+	if err := tracker.CreateInitialMetadata("api", "tracked", projectPath, dbConfig, nil); err != nil {
+		t.Fatalf("Failed to create initial metadata: %v", err)
+	}
+
+	// The generator's endpoints and file manifest must survive the tracker
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to load metadata: %v", err)
+	}
+	if len(projectMetadata.Endpoints) == 0 || len(projectMetadata.Files) == 0 {
+		t.Error("Expected endpoints and file manifest to be preserved in gophex.md")
+	}
+
+	// Legacy activity names are recorded under their current names
+	if err := tracker.UpdateActivity("database_setup", true); err != nil {
+		t.Fatalf("Failed to update activity: %v", err)
+	}
+	if !utils.IsActivityCompleted(projectPath, "database_migrated") {
+		t.Error("Expected database_setup to be recorded as database_migrated")
+	}
+	if tracker.GetActivityPrefix("database_setup") != "Re-" {
+		t.Error("Expected tracker to report database_setup as completed")
+	}
+
+	if _, err := os.Stat(filepath.Join(projectPath, ".gophex-generated")); !os.IsNotExist(err) {
+		t.Error("New projects should not contain a .gophex-generated file")
+	}
+
+	dbType, err := getDatabaseTypeFromMetadata(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to read database type: %v", err)
+	}
+	if dbType != "mysql" {
+		t.Errorf("Expected database type 'mysql', got %s", dbType)
+	}
+}
+
 // TestTemplateProcessing tests template processing functionality
 func TestTemplateProcessing(t *testing.T) {
 
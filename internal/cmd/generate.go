@@ -2,8 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	goversion "go/version"
 	"os"
 	"path/filepath"
+	"slices"
 	"strings"
 
 	"github.com/AlecAivazis/survey/v2"
@@ -23,7 +25,7 @@ func GenerateProject() error {
 		Help: "The Educational Wizard teaches Go architecture patterns while building your project",
 	}
 
-	err := survey.AskOne(approachPrompt, &approach)
+	err := askOne(approachPrompt, &approach)
 	if err != nil {
 		if isUserInterrupt(err) {
 			fmt.Println("\nProject generation cancelled. Goodbye! 👋")
@@ -62,7 +64,7 @@ func runQuickProjectGeneration() error {
 		},
 	}
 
-	err := survey.AskOne(projectTypePrompt, &projectType)
+	err := askOne(projectTypePrompt, &projectType)
 	if err != nil {
 		// Handle user interruption (Ctrl+C) gracefully
 		if isUserInterrupt(err) {
@@ -93,9 +95,10 @@ func runQuickProjectGeneration() error {
 	projectNamePrompt := &survey.Input{
 		Message: "What is the name of your project?",
 		Help:    "This will be used as the directory name and module name",
+		Default: defaultProjectName(projectType),
 	}
 
-	err = survey.AskOne(projectNamePrompt, &projectName, survey.WithValidator(survey.Required))
+	err = askOne(projectNamePrompt, &projectName, survey.WithValidator(survey.Required))
 	if err != nil {
 		// Handle user interruption (Ctrl+C) gracefully
 		if isUserInterrupt(err) {
@@ -104,24 +107,53 @@ func runQuickProjectGeneration() error {
 		return fmt.Errorf("project name input failed: %w", err)
 	}
 
+	goConfig, err := getGoConfiguration()
+	if err != nil {
+		return fmt.Errorf("go version configuration failed: %w", err)
+	}
+
+	minimalDeps, err := getMinimalDepsConfiguration(projectType, goConfig)
+	if err != nil {
+		return fmt.Errorf("dependency policy configuration failed: %w", err)
+	}
+
 	// Get framework and database configuration for API projects
 	var framework string
 	var dbConfig *generator.DatabaseConfig
 	var redisConfig *generator.RedisConfig
+	var dockerConfig *generator.DockerConfig
+	var tracing bool
 	if projectType == "api" {
-		framework, err = getFrameworkConfiguration()
-		if err != nil {
-			return fmt.Errorf("framework configuration failed: %w", err)
+		// Minimal mode routes with net/http and has no Redis client, so skip those questions
+		if !minimalDeps {
+			framework, err = getFrameworkConfiguration()
+			if err != nil {
+				return fmt.Errorf("framework configuration failed: %w", err)
+			}
 		}
 
-		dbConfig, err = getDatabaseConfiguration(projectName)
+		dbConfig, err = getDatabaseConfiguration(projectName, minimalDeps)
 		if err != nil {
 			return fmt.Errorf("database configuration failed: %w", err)
 		}
 
-		redisConfig, err = getRedisConfiguration()
+		if minimalDeps {
+			redisConfig = &generator.RedisConfig{}
+		} else {
+			redisConfig, err = getRedisConfiguration()
+			if err != nil {
+				return fmt.Errorf("redis configuration failed: %w", err)
+			}
+
+			tracing, err = getTracingConfiguration()
+			if err != nil {
+				return fmt.Errorf("tracing configuration failed: %w", err)
+			}
+		}
+
+		dockerConfig, err = getDockerConfiguration()
 		if err != nil {
-			return fmt.Errorf("redis configuration failed: %w", err)
+			return fmt.Errorf("docker configuration failed: %w", err)
 		}
 	}
 
@@ -145,7 +177,7 @@ func runQuickProjectGeneration() error {
 			},
 		}
 
-		err = survey.AskOne(confirmPrompt, &confirm)
+		err = askOne(confirmPrompt, &confirm)
 		if err != nil {
 			if isUserInterrupt(err) {
 				return GetProcessManager().HandleGracefulShutdown()
@@ -172,7 +204,7 @@ func runQuickProjectGeneration() error {
 			},
 		}
 
-		err = survey.AskOne(actionPrompt, &action)
+		err = askOne(actionPrompt, &action)
 		if err != nil {
 			if isUserInterrupt(err) {
 				return GetProcessManager().HandleGracefulShutdown()
@@ -198,7 +230,7 @@ func runQuickProjectGeneration() error {
 			Help:    "Enter the full path or relative path. The project folder will be created inside this directory.",
 		}
 
-		err = survey.AskOne(pathPrompt, &newPath, survey.WithValidator(survey.Required))
+		err = askOne(pathPrompt, &newPath, survey.WithValidator(survey.Required))
 		if err != nil {
 			return fmt.Errorf("path input failed: %w", err)
 		}
@@ -208,7 +240,7 @@ func runQuickProjectGeneration() error {
 	}
 
 	// Generate the project
-	gen := generator.New()
+	gen := generator.New().WithDockerConfig(dockerConfig).WithGoConfig(goConfig).WithMinimalDeps(minimalDeps).WithTracing(tracing)
 	if err := gen.GenerateWithFramework(projectType, projectName, projectPath, framework, dbConfig, redisConfig); err != nil {
 		return fmt.Errorf("error generating project: %w", err)
 	}
@@ -219,6 +251,8 @@ func runQuickProjectGeneration() error {
 		fmt.Printf("⚠️  Warning: Failed to create project tracking metadata: %v\n", err)
 		// Don't fail the entire generation for this
 	}
+	registerProject(projectName, projectType, projectPath)
+	runPostGenerateHooks(projectPath)
 
 	fmt.Printf("✅ Successfully generated %s project '%s' in %s\n", projectType, projectName, projectPath)
 
@@ -232,22 +266,25 @@ func runQuickProjectGeneration() error {
 	return ShowPostGenerationMenu(opts)
 }
 
-func getDatabaseConfiguration(projectName string) (*generator.DatabaseConfig, error) {
+func getDatabaseConfiguration(projectName string, minimalDeps bool) (*generator.DatabaseConfig, error) {
 	config := &generator.DatabaseConfig{}
 
-	// Ask for database type
+	// Ask for database type; minimal mode only offers database/sql databases
+	dbOptions := []string{
+		"PostgreSQL - Advanced open-source relational database",
+		"MySQL - Popular open-source relational database",
+	}
+	if !minimalDeps {
+		dbOptions = append(dbOptions, "MongoDB - Document-oriented NoSQL database")
+	}
+
 	var dbType string
 	dbTypePrompt := &survey.Select{
 		Message: "Which database would you like to use?",
-		Options: []string{
-			"PostgreSQL - Advanced open-source relational database",
-			"MySQL - Popular open-source relational database",
-			"MongoDB - Document-oriented NoSQL database",
-			"Quit",
-		},
+		Options: append(dbOptions, "Quit"),
 	}
 
-	err := survey.AskOne(dbTypePrompt, &dbType)
+	err := askOne(dbTypePrompt, &dbType)
 	if err != nil {
 		if isUserInterrupt(err) {
 			return nil, GetProcessManager().HandleGracefulShutdown()
@@ -282,7 +319,7 @@ func getDatabaseConfiguration(projectName string) (*generator.DatabaseConfig, er
 		},
 	}
 
-	err = survey.AskOne(configTypePrompt, &configType)
+	err = askOne(configTypePrompt, &configType)
 	if err != nil {
 		if isUserInterrupt(err) {
 			return nil, GetProcessManager().HandleGracefulShutdown()
@@ -329,7 +366,7 @@ func getRedisConfiguration() (*generator.RedisConfig, error) {
 		Help: "Redis provides high-performance caching, session storage, and pub/sub capabilities",
 	}
 
-	err := survey.AskOne(redisPrompt, &redisChoice)
+	err := askOne(redisPrompt, &redisChoice)
 	if err != nil {
 		if isUserInterrupt(err) {
 			return nil, GetProcessManager().HandleGracefulShutdown()
@@ -354,7 +391,7 @@ func getRedisConfiguration() (*generator.RedisConfig, error) {
 			Default: "localhost",
 			Help:    "The hostname or IP address of your Redis server",
 		}
-		err = survey.AskOne(hostPrompt, &config.Host, survey.WithValidator(survey.Required))
+		err = askOne(hostPrompt, &config.Host, survey.WithValidator(survey.Required))
 		if err != nil {
 			return nil, err
 		}
@@ -365,7 +402,7 @@ func getRedisConfiguration() (*generator.RedisConfig, error) {
 			Default: "6379",
 			Help:    "The port number for your Redis server",
 		}
-		err = survey.AskOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
+		err = askOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
 		if err != nil {
 			return nil, err
 		}
@@ -374,7 +411,7 @@ func getRedisConfiguration() (*generator.RedisConfig, error) {
 		passwordPrompt := &survey.Password{
 			Message: "Redis password (leave empty if no password):",
 		}
-		err = survey.AskOne(passwordPrompt, &config.Password)
+		err = askOne(passwordPrompt, &config.Password)
 		if err != nil {
 			return nil, err
 		}
@@ -386,7 +423,7 @@ func getRedisConfiguration() (*generator.RedisConfig, error) {
 			Default: "0",
 			Help:    "Redis database number (0-15, typically use 0)",
 		}
-		err = survey.AskOne(dbPrompt, &dbNumber, survey.WithValidator(survey.Required))
+		err = askOne(dbPrompt, &dbNumber, survey.WithValidator(survey.Required))
 		if err != nil {
 			return nil, err
 		}
@@ -403,6 +440,34 @@ func getRedisConfiguration() (*generator.RedisConfig, error) {
 	return config, nil
 }
 
+// getTracingConfiguration asks whether the API should trace requests with OpenTelemetry
+// and correlate its request logs and response headers with the traces
+func getTracingConfiguration() (bool, error) {
+	var choice string
+	tracingPrompt := &survey.Select{
+		Message: "Do you want OpenTelemetry tracing with log correlation?",
+		Options: []string{
+			"No - Skip tracing",
+			"Yes - Trace requests and add trace_id/span_id to logs and response headers",
+			"Quit",
+		},
+		Help: "Every request gets a span, exported over OTLP when OTEL_EXPORTER_OTLP_ENDPOINT is set; its IDs appear in request logs and the X-Trace-Id/X-Span-Id headers",
+	}
+
+	if err := askOne(tracingPrompt, &choice); err != nil {
+		if isUserInterrupt(err) {
+			return false, GetProcessManager().HandleGracefulShutdown()
+		}
+		return false, fmt.Errorf("tracing selection failed: %w", err)
+	}
+
+	if choice == "Quit" {
+		return false, GetProcessManager().HandleGracefulShutdown()
+	}
+
+	return strings.HasPrefix(choice, "Yes"), nil
+}
+
 func getDatabaseCredentials(config *generator.DatabaseConfig, projectName string) error {
 	// Database name
 	dbNamePrompt := &survey.Input{
@@ -410,7 +475,7 @@ func getDatabaseCredentials(config *generator.DatabaseConfig, projectName string
 		Default: projectName,
 		Help:    "The name of the database to connect to",
 	}
-	err := survey.AskOne(dbNamePrompt, &config.DatabaseName, survey.WithValidator(survey.Required))
+	err := askOne(dbNamePrompt, &config.DatabaseName, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -420,7 +485,7 @@ func getDatabaseCredentials(config *generator.DatabaseConfig, projectName string
 		Message: "Database username:",
 		Default: "admin",
 	}
-	err = survey.AskOne(usernamePrompt, &config.Username, survey.WithValidator(survey.Required))
+	err = askOne(usernamePrompt, &config.Username, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -429,7 +494,7 @@ func getDatabaseCredentials(config *generator.DatabaseConfig, projectName string
 	passwordPrompt := &survey.Password{
 		Message: "Database password:",
 	}
-	err = survey.AskOne(passwordPrompt, &config.Password, survey.WithValidator(survey.Required))
+	err = askOne(passwordPrompt, &config.Password, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -453,7 +518,7 @@ func getSingleInstanceConfig(config *generator.DatabaseConfig) error {
 		Message: "Database host:",
 		Default: "localhost",
 	}
-	err := survey.AskOne(hostPrompt, &config.Host, survey.WithValidator(survey.Required))
+	err := askOne(hostPrompt, &config.Host, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -473,7 +538,7 @@ func getSingleInstanceConfig(config *generator.DatabaseConfig) error {
 		Message: "Database port:",
 		Default: defaultPort,
 	}
-	err = survey.AskOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
+	err = askOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -486,7 +551,7 @@ func getSingleInstanceConfig(config *generator.DatabaseConfig) error {
 			Options: []string{"disable", "require", "verify-ca", "verify-full"},
 			Default: "disable",
 		}
-		err = survey.AskOne(sslPrompt, &sslMode)
+		err = askOne(sslPrompt, &sslMode)
 		if err != nil {
 			return err
 		}
@@ -499,7 +564,7 @@ func getSingleInstanceConfig(config *generator.DatabaseConfig) error {
 			Message: "Auth source (optional):",
 			Default: "admin",
 		}
-		survey.AskOne(authSourcePrompt, &config.AuthSource)
+		askOne(authSourcePrompt, &config.AuthSource)
 	}
 
 	return nil
@@ -511,7 +576,7 @@ func getReadWriteConfig(config *generator.DatabaseConfig) error {
 		Message: "Write database host:",
 		Default: "localhost",
 	}
-	err := survey.AskOne(writeHostPrompt, &config.WriteHost, survey.WithValidator(survey.Required))
+	err := askOne(writeHostPrompt, &config.WriteHost, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -526,7 +591,7 @@ func getReadWriteConfig(config *generator.DatabaseConfig) error {
 			"Quit",
 		},
 	}
-	err = survey.AskOne(sameHostPrompt, &sameHost)
+	err = askOne(sameHostPrompt, &sameHost)
 	if err != nil {
 		return err
 	}
@@ -542,7 +607,7 @@ func getReadWriteConfig(config *generator.DatabaseConfig) error {
 			Message: "Read database host:",
 			Default: "localhost",
 		}
-		err = survey.AskOne(readHostPrompt, &config.ReadHost, survey.WithValidator(survey.Required))
+		err = askOne(readHostPrompt, &config.ReadHost, survey.WithValidator(survey.Required))
 		if err != nil {
 			return err
 		}
@@ -563,7 +628,7 @@ func getReadWriteConfig(config *generator.DatabaseConfig) error {
 		Message: "Database port:",
 		Default: defaultPort,
 	}
-	err = survey.AskOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
+	err = askOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -576,7 +641,7 @@ func getReadWriteConfig(config *generator.DatabaseConfig) error {
 			Options: []string{"disable", "require", "verify-ca", "verify-full"},
 			Default: "disable",
 		}
-		err = survey.AskOne(sslPrompt, &sslMode)
+		err = askOne(sslPrompt, &sslMode)
 		if err != nil {
 			return err
 		}
@@ -594,7 +659,7 @@ func getClusterConfig(config *generator.DatabaseConfig) error {
 		Default: "3",
 		Help:    "Enter the number of database nodes in your cluster",
 	}
-	err := survey.AskOne(nodeCountPrompt, &nodeCountStr, survey.WithValidator(survey.Required))
+	err := askOne(nodeCountPrompt, &nodeCountStr, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -607,7 +672,7 @@ func getClusterConfig(config *generator.DatabaseConfig) error {
 			Message: fmt.Sprintf("Cluster node %d host:", i),
 			Default: fmt.Sprintf("node%d.cluster.local", i),
 		}
-		err = survey.AskOne(nodePrompt, &nodeHost, survey.WithValidator(survey.Required))
+		err = askOne(nodePrompt, &nodeHost, survey.WithValidator(survey.Required))
 		if err != nil {
 			return err
 		}
@@ -629,7 +694,7 @@ func getClusterConfig(config *generator.DatabaseConfig) error {
 		Message: "Database port:",
 		Default: defaultPort,
 	}
-	err = survey.AskOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
+	err = askOne(portPrompt, &config.Port, survey.WithValidator(survey.Required))
 	if err != nil {
 		return err
 	}
@@ -640,12 +705,189 @@ func getClusterConfig(config *generator.DatabaseConfig) error {
 			Message: "Replica set name:",
 			Default: "rs0",
 		}
-		survey.AskOne(replicaSetPrompt, &config.ReplicaSet)
+		askOne(replicaSetPrompt, &config.ReplicaSet)
 	}
 
 	return nil
 }
 
+// getGoConfiguration asks which Go release the generated go.mod should target,
+// pinning the installed toolchain when it is new enough
+func getGoConfiguration() (*generator.GoConfig, error) {
+	detected, err := generator.DetectGoConfig()
+	if err != nil {
+		detected = nil
+	}
+
+	options, defaultOption := goVersionOptions(detected)
+	var choice string
+	versionPrompt := &survey.Select{
+		Message: "Which Go version should the project target?",
+		Options: append(options, "Quit"),
+		Default: defaultOption,
+		Help:    "Written as the go directive in go.mod. Go 1.22+ lets webapp and microservice projects route with the standard library ServeMux instead of gorilla/mux",
+	}
+
+	if err := askOne(versionPrompt, &choice); err != nil {
+		if isUserInterrupt(err) {
+			return nil, GetProcessManager().HandleGracefulShutdown()
+		}
+		return nil, fmt.Errorf("go version selection failed: %w", err)
+	}
+
+	if choice == "Quit" {
+		return nil, GetProcessManager().HandleGracefulShutdown()
+	}
+
+	return goConfigForChoice(goVersionName(choice), detected), nil
+}
+
+// dependencyPolicyOptions describes the dependency policies offered by the wizards
+var dependencyPolicyOptions = []string{
+	"Standard - Use established third-party packages (routers, JWT, YAML, Cobra)",
+	"Minimal - Standard library wherever possible (net/http routing, log/slog, database/sql)",
+}
+
+// getMinimalDepsConfiguration asks whether the project should prefer the standard library.
+// It is only offered when the selected Go version supports net/http method routing.
+func getMinimalDepsConfiguration(projectType string, goConfig *generator.GoConfig) (bool, error) {
+	if !minimalDepsAvailable(projectType, goConfig) {
+		return false, nil
+	}
+
+	var choice string
+	policyPrompt := &survey.Select{
+		Message: "Which dependency policy should the project follow?",
+		Options: append(append([]string{}, dependencyPolicyOptions...), "Quit"),
+		Help:    "Minimal suits strict dependency policies: APIs keep only the database driver and golang.org/x/crypto (bcrypt), support PostgreSQL or MySQL, and skip Redis",
+	}
+
+	if err := askOne(policyPrompt, &choice); err != nil {
+		if isUserInterrupt(err) {
+			return false, GetProcessManager().HandleGracefulShutdown()
+		}
+		return false, fmt.Errorf("dependency policy selection failed: %w", err)
+	}
+
+	if choice == "Quit" {
+		return false, GetProcessManager().HandleGracefulShutdown()
+	}
+
+	return strings.HasPrefix(choice, "Minimal"), nil
+}
+
+// minimalDepsAvailable reports whether minimal dependency mode can be offered; every
+// project type except CLIs needs the Go 1.22 ServeMux for routing
+func minimalDepsAvailable(projectType string, goConfig *generator.GoConfig) bool {
+	if projectType == "cli" || goConfig == nil {
+		return true
+	}
+	return goversion.Compare("go"+goConfig.Version, "go"+generator.MinimalDepsGoVersion) >= 0
+}
+
+// goVersionOptions lists the Go versions offered by the wizards, defaulting to the installed release
+func goVersionOptions(detected *generator.GoConfig) ([]string, string) {
+	descriptions := map[string]string{
+		"1.22": "ServeMux method and wildcard patterns, per-iteration loop variables",
+		"1.21": "minimum supported; webapp and microservice use gorilla/mux",
+	}
+
+	versions := append([]string{}, generator.SupportedGoVersions...)
+	if detected != nil && goversion.Compare("go"+detected.Version, "go"+versions[0]) > 0 {
+		versions = append([]string{detected.Version}, versions...)
+	}
+
+	defaultVersion := generator.DefaultGoVersion
+	if detected != nil && slices.Contains(versions, detected.Version) {
+		defaultVersion = detected.Version
+	}
+
+	var options []string
+	var defaultOption string
+	for _, v := range versions {
+		option := v
+		if detected != nil && v == detected.Version {
+			option += fmt.Sprintf(" (installed: %s)", detected.Toolchain)
+		}
+		if description, ok := descriptions[v]; ok {
+			option += " - " + description
+		}
+		if v == defaultVersion {
+			defaultOption = option
+		}
+		options = append(options, option)
+	}
+	return options, defaultOption
+}
+
+// goConfigForChoice builds the go.mod settings for a selected version, pinning the
+// installed toolchain only when it can build that version
+func goConfigForChoice(version string, detected *generator.GoConfig) *generator.GoConfig {
+	config := &generator.GoConfig{Version: version}
+	if detected != nil && goversion.Compare(detected.Toolchain, "go"+version) >= 0 {
+		config.Toolchain = detected.Toolchain
+	}
+	return config
+}
+
+// goVersionName extracts the version from a Go version option
+func goVersionName(option string) string {
+	return strings.Fields(option)[0]
+}
+
+// runtimeImageOptions describes the container runtime images offered by the wizards
+var runtimeImageOptions = []string{
+	"distroless - Minimal image with no shell, runs as non-root (recommended)",
+	"alpine - Small image with a shell and package manager for debugging",
+	"scratch - Empty image containing only the binary, smallest possible",
+}
+
+// getDockerConfiguration asks which runtime image and platforms the generated Dockerfile should target
+func getDockerConfiguration() (*generator.DockerConfig, error) {
+	var imageChoice string
+	imagePrompt := &survey.Select{
+		Message: "Which runtime image should the Dockerfile use?",
+		Options: append(append([]string{}, runtimeImageOptions...), "Quit"),
+		Help:    "The application is compiled in a separate build stage; this only chooses what it runs on",
+	}
+
+	if err := askOne(imagePrompt, &imageChoice); err != nil {
+		if isUserInterrupt(err) {
+			return nil, GetProcessManager().HandleGracefulShutdown()
+		}
+		return nil, fmt.Errorf("runtime image selection failed: %w", err)
+	}
+
+	if imageChoice == "Quit" {
+		return nil, GetProcessManager().HandleGracefulShutdown()
+	}
+
+	var platforms []string
+	platformPrompt := &survey.MultiSelect{
+		Message: "Which platforms should multi-arch builds target?",
+		Options: []string{"linux/amd64", "linux/arm64", "linux/arm/v7"},
+		Default: generator.DefaultPlatforms,
+		Help:    "Used by 'make docker-buildx' and the CI workflow; arm64 covers Apple Silicon and AWS Graviton",
+	}
+
+	if err := askOne(platformPrompt, &platforms, survey.WithValidator(survey.MinItems(1))); err != nil {
+		if isUserInterrupt(err) {
+			return nil, GetProcessManager().HandleGracefulShutdown()
+		}
+		return nil, fmt.Errorf("platform selection failed: %w", err)
+	}
+
+	return &generator.DockerConfig{
+		RuntimeImage: runtimeImageName(imageChoice),
+		Platforms:    platforms,
+	}, nil
+}
+
+// runtimeImageName extracts the image name from a runtime image option
+func runtimeImageName(option string) string {
+	return strings.SplitN(option, " - ", 2)[0]
+}
+
 func getFrameworkConfiguration() (string, error) {
 	var framework string
 	frameworkPrompt := &survey.Select{
@@ -659,7 +901,7 @@ func getFrameworkConfiguration() (string, error) {
 		Help: "Choose the web framework that best fits your project needs",
 	}
 
-	err := survey.AskOne(frameworkPrompt, &framework)
+	err := askOne(frameworkPrompt, &framework)
 	if err != nil {
 		if isUserInterrupt(err) {
 			return "", GetProcessManager().HandleGracefulShutdown()
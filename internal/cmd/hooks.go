@@ -0,0 +1,258 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/registry"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/utils"
+	"github.com/buildwithhp/gophex/pkg/version"
+)
+
+// hooksUsage describes the hooks subcommand
+const hooksUsage = "gophex hooks [list | run EVENT] [--format table|json] [--path DIR]"
+
+// defaultHooksFile holds hooks copied into every newly generated project
+const defaultHooksFile = "hooks.json"
+
+// hookListing is one configured hook as shown by "gophex hooks list"
+type hookListing struct {
+	Event   string `json:"event"`
+	Name    string `json:"name"`
+	Run     string `json:"run"`
+	Timeout string `json:"timeout"`
+	LastRun string `json:"last_run,omitempty"`
+	Status  string `json:"status,omitempty"`
+}
+
+// runHooks implements "gophex hooks [list | run EVENT] [--format table|json] [--path DIR]"
+func runHooks(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("hooks", flag.ContinueOnError)
+	format := fs.String("format", "table", "output format: table or json")
+	projectPath := fs.String("path", ".", "project directory containing gophex.md")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+	if len(positional) == 0 {
+		positional = []string{"list"}
+	}
+
+	switch {
+	case positional[0] == "list" && len(positional) == 1:
+		if err := validateFormat(*format); err != nil {
+			return err
+		}
+		return listHooks(*projectPath, *format, out)
+	case positional[0] == "run" && len(positional) == 2:
+		return runProjectHooks(*projectPath, positional[1], nil, out)
+	default:
+		return usageError(hooksUsage)
+	}
+}
+
+// listHooks prints the project's hooks with the outcome of each event's last run
+func listHooks(projectPath, format string, out io.Writer) error {
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project metadata: %w", err)
+	}
+	if err := metadata.ValidateHooks(projectMetadata.Hooks); err != nil {
+		return err
+	}
+
+	listings := []hookListing{}
+	for _, event := range metadata.HookEvents {
+		activity, ran := projectMetadata.Activities[metadata.HookActivityName(event)]
+		for _, hook := range projectMetadata.Hooks[event] {
+			timeout, _ := hook.TimeoutDuration()
+			listing := hookListing{Event: event, Name: hook.DisplayName(), Run: hook.Run, Timeout: timeout.String()}
+			if ran {
+				listing.LastRun = activity.Timestamp
+				listing.Status = "failed"
+				if activity.Completed {
+					listing.Status = "succeeded"
+				}
+			}
+			listings = append(listings, listing)
+		}
+	}
+
+	if strings.ToLower(format) == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(listings)
+	}
+
+	if len(listings) == 0 {
+		fmt.Fprintf(out, "No hooks configured. Add a \"hooks\" section to gophex.md with %s commands.\n", strings.Join(metadata.HookEvents, ", "))
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "EVENT\tNAME\tTIMEOUT\tLAST RUN\tSTATUS")
+	for _, l := range listings {
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", l.Event, l.Name, l.Timeout, orDash(l.LastRun), orDash(l.Status))
+	}
+	return w.Flush()
+}
+
+// runProjectHooks runs the project's hooks for event in order, stopping at the first failure,
+// and records the outcome in the project activities. env is added to every hook's environment.
+// Projects without gophex.md or without hooks for the event are left alone.
+func runProjectHooks(projectPath, event string, env map[string]string, out io.Writer) error {
+	if !metadata.IsHookEvent(event) {
+		return failure.User(fmt.Errorf("unknown hook event %q (use one of: %s)", event, strings.Join(metadata.HookEvents, ", ")), "")
+	}
+	if !utils.HasGophexMetadata(projectPath) {
+		return nil
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project metadata: %w", err)
+	}
+
+	hooks := projectMetadata.Hooks[event]
+	if len(hooks) == 0 {
+		return nil
+	}
+	if err := metadata.ValidateHooks(projectMetadata.Hooks); err != nil {
+		return err
+	}
+
+	hookEnv, err := hookEnvironment(projectPath, projectMetadata, event, env)
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "🪝 Running %d %s hook(s)...\n", len(hooks), event)
+
+	var runErr error
+	for _, hook := range hooks {
+		start := time.Now()
+		if runErr = runHook(projectPath, hook, hookEnv, out); runErr != nil {
+			runErr = failure.Tool(fmt.Errorf("%s hook %q failed: %w", event, hook.DisplayName(), runErr),
+				"fix the hook command in gophex.md, or try it with: gophex hooks run "+event)
+			fmt.Fprintf(out, "❌ %v\n", runErr)
+			break
+		}
+		fmt.Fprintf(out, "✅ %s (%s)\n", hook.DisplayName(), time.Since(start).Round(time.Millisecond))
+	}
+
+	if err := metadata.RecordHookRun(projectPath, event, runErr == nil); err != nil {
+		fmt.Fprintf(out, "⚠️  Warning: Could not record the %s hooks in gophex.md: %v\n", event, err)
+	}
+
+	return runErr
+}
+
+// runHook runs one hook through the system shell in the project directory, killing it when it
+// outlives its timeout. Its output goes to out as it is produced.
+func runHook(projectPath string, hook metadata.HookCommand, env []string, out io.Writer) error {
+	timeout, err := hook.TimeoutDuration()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	name, args := currentPlatform().shellCommand(hook.Run)
+	cmd := exec.CommandContext(ctx, name, args...)
+	cmd.Dir = projectPath
+	cmd.Env = append(append(os.Environ(), env...), sortedEnv(hook.Env)...)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	// Don't wait for background processes the hook started to close its output
+	cmd.WaitDelay = time.Second
+
+	err = cmd.Run()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return fmt.Errorf("timed out after %s", timeout)
+	}
+	return err
+}
+
+// hookEnvironment returns the variables Gophex passes to every hook of an event
+func hookEnvironment(projectPath string, projectMetadata *metadata.ProjectMetadata, event string, extra map[string]string) ([]string, error) {
+	absPath, err := filepath.Abs(projectPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	env := []string{
+		"GOPHEX_HOOK=" + event,
+		"GOPHEX_VERSION=" + version.GetVersion(),
+		"GOPHEX_PROJECT_NAME=" + projectMetadata.Project.Name,
+		"GOPHEX_PROJECT_TYPE=" + projectMetadata.Project.Type,
+		"GOPHEX_PROJECT_PATH=" + absPath,
+		"GOPHEX_MODULE_PATH=" + projectMetadata.Project.ModulePath,
+		"GOPHEX_DATABASE_TYPE=" + projectMetadata.Database.Type,
+	}
+	return append(env, sortedEnv(extra)...), nil
+}
+
+// sortedEnv renders variables as KEY=VALUE pairs in key order
+func sortedEnv(vars map[string]string) []string {
+	env := make([]string, 0, len(vars))
+	for _, key := range sortedKeys(vars) {
+		env = append(env, key+"="+vars[key])
+	}
+	return env
+}
+
+// seedDefaultHooks copies the hooks in $GOPHEX_HOME/hooks.json into a new project's gophex.md,
+// so post_generate hooks can run for projects that did not exist a moment ago
+func seedDefaultHooks(projectPath string) error {
+	data, err := os.ReadFile(filepath.Join(registry.Dir(), defaultHooksFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to read default hooks: %w", err)
+	}
+
+	var hooks map[string][]metadata.HookCommand
+	if err := json.Unmarshal(data, &hooks); err != nil {
+		return fmt.Errorf("failed to parse %s: %w", defaultHooksFile, err)
+	}
+	if len(hooks) == 0 {
+		return nil
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project metadata: %w", err)
+	}
+	if len(projectMetadata.Hooks) > 0 {
+		return nil
+	}
+
+	return metadata.SetHooks(projectPath, hooks)
+}
+
+// runPostGenerateHooks seeds a newly generated project's hooks and runs its post_generate hooks.
+// The project already exists, so failures are reported without failing generation.
+func runPostGenerateHooks(projectPath string) {
+	if err := seedDefaultHooks(projectPath); err != nil {
+		fmt.Printf("⚠️  Warning: Could not apply default hooks: %v\n", err)
+		return
+	}
+	if err := runProjectHooks(projectPath, metadata.HookPostGenerate, nil, os.Stdout); err != nil {
+		fmt.Printf("⚠️  Warning: %v\n", err)
+	}
+}
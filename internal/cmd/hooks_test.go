@@ -0,0 +1,164 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/testutil"
+)
+
+// writeHooksProject creates a project whose gophex.md declares the given hooks
+func writeHooksProject(t *testing.T, hooks map[string][]metadata.HookCommand) string {
+	t.Helper()
+	return testutil.WriteProject(t, &metadata.ProjectMetadata{
+		Project: metadata.ProjectInfo{Name: "shop", Type: "api", ModulePath: "github.com/acme/shop"},
+		Hooks:   hooks,
+	}, nil)
+}
+
+func TestRunProjectHooks(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("hook commands in this test use sh syntax")
+	}
+
+	t.Run("injects environment and records success", func(t *testing.T) {
+		projectPath := writeHooksProject(t, map[string][]metadata.HookCommand{
+			metadata.HookPostCRUD: {
+				{Name: "record", Run: `echo "$GOPHEX_HOOK $GOPHEX_PROJECT_NAME $GOPHEX_MODULE_PATH $GOPHEX_ENTITY $TARGET" > hook.txt`, Env: map[string]string{"TARGET": "staging"}},
+			},
+		})
+
+		var out bytes.Buffer
+		if err := runProjectHooks(projectPath, metadata.HookPostCRUD, map[string]string{"GOPHEX_ENTITY": "product"}, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		content, err := os.ReadFile(filepath.Join(projectPath, "hook.txt"))
+		if err != nil {
+			t.Fatalf("Expected the hook to run in the project directory: %v", err)
+		}
+		if got := strings.TrimSpace(string(content)); got != "post_crud shop github.com/acme/shop product staging" {
+			t.Errorf("Expected injected environment, got %q", got)
+		}
+
+		projectMetadata, err := metadata.LoadMetadata(projectPath)
+		if err != nil {
+			t.Fatalf("Failed to load metadata: %v", err)
+		}
+		activity := projectMetadata.Activities[metadata.HookActivityName(metadata.HookPostCRUD)]
+		if !activity.Completed || activity.Timestamp == "" {
+			t.Errorf("Expected a completed hooks_post_crud activity, got %+v", activity)
+		}
+	})
+
+	t.Run("stops at the first failure", func(t *testing.T) {
+		projectPath := writeHooksProject(t, map[string][]metadata.HookCommand{
+			metadata.HookPreStart: {
+				{Name: "lint", Run: "exit 3"},
+				{Name: "never", Run: "touch never.txt"},
+			},
+		})
+
+		err := runProjectHooks(projectPath, metadata.HookPreStart, nil, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), `pre_start hook "lint" failed`) {
+			t.Fatalf("Expected lint failure, got %v", err)
+		}
+		if _, err := os.Stat(filepath.Join(projectPath, "never.txt")); !os.IsNotExist(err) {
+			t.Error("Expected hooks after a failure to be skipped")
+		}
+
+		projectMetadata, _ := metadata.LoadMetadata(projectPath)
+		if activity := projectMetadata.Activities[metadata.HookActivityName(metadata.HookPreStart)]; activity.Completed || activity.Timestamp == "" {
+			t.Errorf("Expected a failed hooks_pre_start activity, got %+v", activity)
+		}
+	})
+
+	t.Run("kills hooks that time out", func(t *testing.T) {
+		projectPath := writeHooksProject(t, map[string][]metadata.HookCommand{
+			metadata.HookPostGenerate: {{Run: "sleep 5", Timeout: "100ms"}},
+		})
+
+		err := runProjectHooks(projectPath, metadata.HookPostGenerate, nil, &bytes.Buffer{})
+		if err == nil || !strings.Contains(err.Error(), "timed out after 100ms") {
+			t.Errorf("Expected timeout error, got %v", err)
+		}
+	})
+
+	t.Run("no hooks for event", func(t *testing.T) {
+		projectPath := writeHooksProject(t, nil)
+
+		var out bytes.Buffer
+		if err := runProjectHooks(projectPath, metadata.HookPreStart, nil, &out); err != nil || out.Len() != 0 {
+			t.Errorf("Expected nothing to run, got %v and %q", err, out.String())
+		}
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		projectPath := writeHooksProject(t, nil)
+
+		if err := runProjectHooks(projectPath, "post_deploy", nil, &bytes.Buffer{}); err == nil {
+			t.Error("Expected error for an unknown event")
+		}
+	})
+}
+
+func TestSeedDefaultHooks(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	defaults := `{"post_generate": [{"name": "git", "run": "git init -q"}]}`
+	if err := os.WriteFile(filepath.Join(home, defaultHooksFile), []byte(defaults), 0644); err != nil {
+		t.Fatalf("Failed to write default hooks: %v", err)
+	}
+
+	projectPath := writeHooksProject(t, nil)
+	if err := seedDefaultHooks(projectPath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to load metadata: %v", err)
+	}
+	hooks := projectMetadata.Hooks[metadata.HookPostGenerate]
+	if len(hooks) != 1 || hooks[0].Run != "git init -q" {
+		t.Errorf("Expected the default post_generate hook, got %+v", projectMetadata.Hooks)
+	}
+
+	// Projects with their own hooks keep them
+	projectPath = writeHooksProject(t, map[string][]metadata.HookCommand{
+		metadata.HookPreStart: {{Run: "make lint"}},
+	})
+	if err := seedDefaultHooks(projectPath); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	projectMetadata, _ = metadata.LoadMetadata(projectPath)
+	if _, seeded := projectMetadata.Hooks[metadata.HookPostGenerate]; seeded {
+		t.Error("Expected existing hooks not to be replaced")
+	}
+}
+
+func TestRunHooksList(t *testing.T) {
+	projectPath := writeHooksProject(t, map[string][]metadata.HookCommand{
+		metadata.HookPreStart: {{Name: "lint", Run: "make lint", Timeout: "30s"}},
+	})
+
+	var out bytes.Buffer
+	if err := runHooks([]string{"list", "--path", projectPath}, &out); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	for _, expected := range []string{"EVENT", "pre_start", "lint", "30s"} {
+		if !strings.Contains(out.String(), expected) {
+			t.Errorf("Expected output to contain %q, got %q", expected, out.String())
+		}
+	}
+
+	if err := runHooks([]string{"run", "--path", projectPath}, &out); err == nil {
+		t.Error("Expected usage error for run without an event")
+	}
+}
@@ -0,0 +1,139 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// inspectUsage describes the inspect subcommand
+const inspectUsage = "gophex inspect endpoints|entities|features|activities [--format table|json] [--path DIR]"
+
+// inspectResources lists the metadata sections that "gophex inspect" can query
+var inspectResources = []string{"endpoints", "entities", "features", "activities"}
+
+// runInspect implements "gophex inspect <resource> [--format table|json] [--path DIR]"
+func runInspect(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("inspect", flag.ContinueOnError)
+	format := fs.String("format", "table", "output format: table or json")
+	projectPath := fs.String("path", ".", "project directory containing gophex.md")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 1 {
+		return usageError(inspectUsage)
+	}
+
+	if err := validateFormat(*format); err != nil {
+		return err
+	}
+
+	resource := strings.ToLower(positional[0])
+	if _, err := os.Stat(*projectPath); err != nil {
+		return failure.Environment(fmt.Errorf("project directory not found: %s", *projectPath), utils.ProjectDirHint)
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(*projectPath)
+	if err != nil {
+		return fmt.Errorf("failed to load project metadata: %w", err)
+	}
+
+	var data interface{}
+	switch resource {
+	case "endpoints":
+		data = projectMetadata.Endpoints
+	case "entities":
+		entities, err := metadata.ListEntities(*projectPath, projectMetadata)
+		if err != nil {
+			return err
+		}
+		data = entities
+	case "features":
+		data = projectMetadata.Features
+	case "activities":
+		data = projectMetadata.Activities
+	default:
+		return failure.User(fmt.Errorf("unknown resource %q (choose from: %s)", resource, strings.Join(inspectResources, ", ")), "")
+	}
+
+	if strings.ToLower(*format) == "json" {
+		encoder := json.NewEncoder(out)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(data)
+	}
+
+	return writeInspectTable(out, resource, data)
+}
+
+// writeInspectTable renders a metadata section as an aligned text table
+func writeInspectTable(out io.Writer, resource string, data interface{}) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+
+	switch items := data.(type) {
+	case []metadata.EndpointInfo:
+		fmt.Fprintln(w, "METHOD\tPATH\tAUTH\tREQUEST\tRESPONSE\tTAGS\tDESCRIPTION")
+		for _, e := range items {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", e.Method, e.Path, orDash(e.Auth),
+				orDash(e.RequestSchema), orDash(e.ResponseSchema), orDash(strings.Join(e.Tags, ",")), e.Description)
+		}
+	case []metadata.EntityInfo:
+		fmt.Fprintln(w, "NAME\tPLURAL\tFIELDS\tUPDATE\tLAYERS\tGENERATED")
+		for _, e := range items {
+			fields := make([]string, 0, len(e.Fields))
+			for _, field := range e.Fields {
+				fields = append(fields, field.Name)
+			}
+			layers := "all"
+			if len(e.Layers) > 0 {
+				layers = strings.Join(e.Layers, ",")
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\t%s\n", e.Name, orDash(e.PluralName),
+				orDash(strings.Join(fields, ",")), orDash(e.UpdateMethod), layers, orDash(e.GeneratedAt))
+		}
+	case map[string]bool:
+		fmt.Fprintln(w, "FEATURE\tENABLED")
+		for _, name := range sortedKeys(items) {
+			fmt.Fprintf(w, "%s\t%t\n", name, items[name])
+		}
+	case map[string]metadata.ActivityInfo:
+		fmt.Fprintln(w, "ACTIVITY\tCOMPLETED\tREPEATABLE\tTIMESTAMP")
+		for _, name := range sortedKeys(items) {
+			activity := items[name]
+			fmt.Fprintf(w, "%s\t%t\t%t\t%s\n", name, activity.Completed, activity.CanRepeat, orDash(activity.Timestamp))
+		}
+	default:
+		return fmt.Errorf("cannot render %s as a table", resource)
+	}
+
+	return w.Flush()
+}
+
+// sortedKeys returns the keys of a string-keyed map in sorted order
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// orDash returns "-" for empty table cells
+func orDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
@@ -0,0 +1,132 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/testutil"
+)
+
+func TestParseCommandFlags(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		resource string
+		format   string
+	}{
+		{"flags after resource", []string{"endpoints", "--format", "json"}, "endpoints", "json"},
+		{"flags before resource", []string{"--format=json", "features"}, "features", "json"},
+		{"no flags", []string{"activities"}, "activities", "table"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			format := fs.String("format", "table", "")
+
+			positional, err := parseCommandFlags(fs, test.args)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if len(positional) != 1 || positional[0] != test.resource {
+				t.Errorf("Expected positional [%s], got %v", test.resource, positional)
+			}
+			if *format != test.format {
+				t.Errorf("Expected format %s, got %s", test.format, *format)
+			}
+		})
+	}
+}
+
+func TestRunInspect(t *testing.T) {
+	tempDir := testutil.WriteProject(t, &metadata.ProjectMetadata{
+		Project:    metadata.ProjectInfo{Name: "inspectme", Type: "api"},
+		Features:   map[string]bool{"authentication": true},
+		Activities: map[string]metadata.ActivityInfo{"project_generated": {Completed: true}},
+		Endpoints:  []metadata.EndpointInfo{{Method: "GET", Path: "/api/v1/health", Description: "Health check", Auth: "none"}},
+		Entities: []metadata.EntityInfo{{Name: "product", PluralName: "products", Fields: []metadata.EntityField{
+			{Name: "Name", Type: "string", Required: true},
+		}}},
+	}, map[string]string{"internal/domain/user/model.go": "package user\n"})
+
+	t.Run("endpoints table", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runInspect([]string{"endpoints", "--path", tempDir}, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if !strings.Contains(out.String(), "/api/v1/health") || !strings.Contains(out.String(), "METHOD") {
+			t.Errorf("Expected endpoint table, got:\n%s", out.String())
+		}
+	})
+
+	t.Run("entities json", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runInspect([]string{"entities", "--format", "json", "--path", tempDir}, &out); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+
+		var entities []map[string]interface{}
+		if err := json.Unmarshal(out.Bytes(), &entities); err != nil {
+			t.Fatalf("Expected valid JSON, got error: %v", err)
+		}
+		if len(entities) != 2 {
+			t.Errorf("Expected recorded and scaffolded entities, got %d", len(entities))
+		}
+	})
+
+	t.Run("unknown resource", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runInspect([]string{"widgets", "--path", tempDir}, &out)
+		if err == nil {
+			t.Fatal("Expected error for unknown resource")
+		}
+		if failure.KindOf(err) != failure.KindUser {
+			t.Errorf("Expected a user error, got %s", failure.KindOf(err))
+		}
+	})
+
+	t.Run("unsupported format", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := runInspect([]string{"features", "--format", "yaml", "--path", tempDir}, &out); err == nil {
+			t.Error("Expected error for unsupported format")
+		}
+	})
+
+	t.Run("missing project", func(t *testing.T) {
+		var out bytes.Buffer
+		err := runInspect([]string{"features", "--path", filepath.Join(tempDir, "missing")}, &out)
+		if failure.KindOf(err) != failure.KindEnvironment {
+			t.Errorf("Expected an environment error, got %v (%s)", err, failure.KindOf(err))
+		}
+		if failure.HintOf(err) == "" {
+			t.Error("Expected a hint pointing at --path")
+		}
+	})
+}
+
+func TestCommandErrorsExitCodes(t *testing.T) {
+	tests := []struct {
+		name     string
+		args     []string
+		expected int
+	}{
+		{"unknown command", []string{"frobnicate"}, 2},
+		{"unknown flag", []string{"inspect", "endpoints", "--colour"}, 2},
+		{"wrong arguments", []string{"inspect"}, 2},
+		{"not a project", []string{"blame", "--path", t.TempDir()}, 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if code := failure.ExitCode(ExecuteCommand(tt.args)); code != tt.expected {
+				t.Errorf("Expected exit code %d, got %d", tt.expected, code)
+			}
+		})
+	}
+}
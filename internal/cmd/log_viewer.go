@@ -0,0 +1,147 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/AlecAivazis/survey/v2"
+)
+
+// startupLogLines is how much output is shown right after an application starts
+const startupLogLines = 15
+
+// recentLogLines is how much output "Show recent logs" prints
+const recentLogLines = 100
+
+// logFollowInterval is how often follow mode polls for new output
+const logFollowInterval = 250 * time.Millisecond
+
+// logLevelFilters are the level filters offered by the log viewer and the minimum level each shows
+var logLevelFilters = []struct {
+	Label    string
+	MinLevel string
+}{
+	{"All levels", "DEBUG"},
+	{"INFO and above", "INFO"},
+	{"WARN and above", "WARN"},
+	{"ERROR only", "ERROR"},
+}
+
+// ViewApplicationLogs shows the captured output of the application started for a project
+func ViewApplicationLogs(projectPath string) error {
+	logs := GetProcessManager().LogsFor(projectPath)
+	if logs == nil {
+		fmt.Println("ℹ️  No application has been started from Gophex for this project yet")
+		return nil
+	}
+
+	status := "running"
+	if !logs.Running() {
+		status = "exited"
+	}
+	fmt.Printf("📜 Application logs (%s) - %s\n", status, logs.Path())
+
+	levelOptions := make([]string, 0, len(logLevelFilters))
+	for _, filter := range logLevelFilters {
+		levelOptions = append(levelOptions, filter.Label)
+	}
+
+	var levelIndex int
+	levelPrompt := &survey.Select{
+		Message: "Which log levels would you like to see?",
+		Options: levelOptions,
+	}
+	if err := askOne(levelPrompt, &levelIndex); err != nil {
+		if isUserInterrupt(err) {
+			return nil
+		}
+		return err
+	}
+	minLevel := logLevelFilters[levelIndex].MinLevel
+
+	var modeChoice string
+	modePrompt := &survey.Select{
+		Message: "How would you like to view the logs?",
+		Options: []string{
+			"Show recent logs",
+			"Follow logs (press Enter to stop)",
+			"Back",
+		},
+	}
+	if err := askOne(modePrompt, &modeChoice); err != nil {
+		if isUserInterrupt(err) {
+			return nil
+		}
+		return err
+	}
+
+	switch modeChoice {
+	case "Show recent logs":
+		printLogLines(os.Stdout, logs, 0, minLevel, recentLogLines)
+	case "Follow logs (press Enter to stop)":
+		stop := make(chan struct{})
+		go func() {
+			bufio.NewReader(os.Stdin).ReadString('\n')
+			close(stop)
+		}()
+		followLogs(os.Stdout, logs, minLevel, stop)
+	}
+
+	return nil
+}
+
+// printLogTail prints the most recent lines of application output
+func printLogTail(logs *LogBuffer, limit int) {
+	fmt.Println("---")
+	printLogLines(os.Stdout, logs, 0, "DEBUG", limit)
+	fmt.Println("---")
+}
+
+// printLogLines writes at most limit lines newer than since at or above minLevel and returns the last sequence printed
+func printLogLines(out io.Writer, logs *LogBuffer, since int, minLevel string, limit int) int {
+	lines := logs.Lines(since, minLevel)
+	if limit > 0 && len(lines) > limit {
+		lines = lines[len(lines)-limit:]
+	}
+
+	if len(lines) == 0 && since == 0 {
+		fmt.Fprintln(out, "(no output yet)")
+	}
+
+	for _, line := range lines {
+		fmt.Fprintln(out, formatLogLine(line))
+		since = line.Seq
+	}
+	return since
+}
+
+// followLogs streams new output until stop is closed or the application exits
+func followLogs(out io.Writer, logs *LogBuffer, minLevel string, stop <-chan struct{}) {
+	fmt.Fprintln(out, "👀 Following logs - press Enter to return to the menu")
+	since := printLogLines(out, logs, 0, minLevel, recentLogLines)
+
+	ticker := time.NewTicker(logFollowInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+			// Lines are read before checking for exit so the final output is never missed
+			running := logs.Running()
+			for _, line := range logs.Lines(since, minLevel) {
+				fmt.Fprintln(out, formatLogLine(line))
+				since = line.Seq
+			}
+			if !running {
+				fmt.Fprintln(out, "⏹️  Application has exited - press Enter to return to the menu")
+				<-stop
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,353 @@
+package cmd
+
+import (
+	"flag"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// renameModuleUsage describes the rename-module subcommand
+const renameModuleUsage = "gophex rename-module NEW_PATH [--from OLD_PATH] [--dry-run] [--path DIR]"
+
+// moduleLinePattern matches the module directive of a go.mod file
+var moduleLinePattern = regexp.MustCompile(`(?m)^module\s+\S+`)
+
+// moduleImport is a project-local import whose path is rooted at a module
+type moduleImport struct {
+	File string // relative to the project
+	Path string
+}
+
+// resolveModulePath returns the module path generated imports must use. The path is
+// cached in gophex.md; a cache that disagrees with go.mod, or imports rooted at another
+// module, mean the project was moved or renamed and generation stops before writing files.
+func resolveModulePath(projectPath string) (string, error) {
+	modulePath, err := utils.ReadModulePath(projectPath)
+	if err != nil {
+		return "", err
+	}
+
+	projectMetadata, err := metadata.LoadMetadata(projectPath)
+	if err != nil {
+		return "", fmt.Errorf("failed to load project metadata: %w", err)
+	}
+
+	cached := projectMetadata.Project.ModulePath
+	if cached != "" && cached != modulePath {
+		return "", failure.User(fmt.Errorf("module path mismatch: gophex.md records %q but go.mod declares %q; "+
+			"if the project was moved or renamed, update its imports with: %s",
+			cached, modulePath, renameModuleCommand(projectPath, modulePath, "")), "")
+	}
+
+	stale, err := findForeignImports(projectPath, modulePath)
+	if err != nil {
+		return "", err
+	}
+	if len(stale) > 0 {
+		return "", failure.User(fmt.Errorf("%s imports %q, which is not part of module %q; "+
+			"if the project was moved or renamed, update its imports with: %s",
+			stale[0].File, stale[0].Path, modulePath, renameModuleCommand(projectPath, modulePath, importModuleRoot(stale[0].Path))), "")
+	}
+
+	if cached == "" {
+		if err := metadata.SetModulePath(projectPath, modulePath); err != nil {
+			fmt.Printf("⚠️  Warning: Could not record the module path in gophex.md: %v\n", err)
+		}
+	}
+
+	return modulePath, nil
+}
+
+// renameModuleCommand returns the rename-module invocation that fixes a project's imports
+func renameModuleCommand(projectPath, newPath, from string) string {
+	command := "gophex rename-module " + newPath
+	if from != "" {
+		command += " --from " + from
+	}
+	if projectPath != "." {
+		command += " --path " + projectPath
+	}
+	return command
+}
+
+// importModuleRoot returns the module part of an import of an internal package
+func importModuleRoot(importPath string) string {
+	if i := strings.Index(importPath, "/internal/"); i >= 0 {
+		return importPath[:i]
+	}
+	return strings.TrimSuffix(importPath, "/internal")
+}
+
+// findForeignImports returns imports of internal packages outside modulePath. Go only lets
+// a module import its own internal packages, so these are left over from an earlier module path.
+func findForeignImports(projectPath, modulePath string) ([]moduleImport, error) {
+	imports, err := scanImports(projectPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var foreign []moduleImport
+	for _, imp := range imports {
+		isInternal := strings.Contains(imp.Path, "/internal/") || strings.HasSuffix(imp.Path, "/internal")
+		if isInternal && !inModule(imp.Path, modulePath) {
+			foreign = append(foreign, imp)
+		}
+	}
+	return foreign, nil
+}
+
+// inModule reports whether importPath belongs to modulePath
+func inModule(importPath, modulePath string) bool {
+	return importPath == modulePath || strings.HasPrefix(importPath, modulePath+"/")
+}
+
+// scanImports lists the imports of every Go file in the project, skipping vendored and hidden directories
+// and files whose import block does not parse
+func scanImports(projectPath string) ([]moduleImport, error) {
+	var imports []moduleImport
+	fset := token.NewFileSet()
+
+	err := filepath.WalkDir(projectPath, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			name := d.Name()
+			if path != projectPath && (name == "vendor" || name == "testdata" || strings.HasPrefix(name, ".")) {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !strings.HasSuffix(path, ".go") {
+			return nil
+		}
+
+		rel, _ := filepath.Rel(projectPath, path)
+		file, err := parser.ParseFile(fset, path, nil, parser.ImportsOnly)
+		if err != nil {
+			// One broken file, often one being edited, must not block generation
+			fmt.Printf("⚠️  Warning: Could not read the imports of %s, skipping it: %v\n", rel, err)
+			return nil
+		}
+
+		for _, spec := range file.Imports {
+			importPath, err := strconv.Unquote(spec.Path.Value)
+			if err != nil {
+				continue
+			}
+			imports = append(imports, moduleImport{File: rel, Path: importPath})
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to scan imports: %w", err)
+	}
+
+	return imports, nil
+}
+
+// runRenameModule implements "gophex rename-module NEW_PATH [--from OLD_PATH] [--dry-run] [--path DIR]"
+func runRenameModule(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("rename-module", flag.ContinueOnError)
+	from := fs.String("from", "", "module path to rewrite (default: the path recorded in gophex.md, else go.mod's)")
+	dryRun := fs.Bool("dry-run", false, "show the changes without editing files")
+	projectPath := fs.String("path", ".", "project directory containing go.mod")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 1 {
+		return usageError(renameModuleUsage)
+	}
+	newPath := positional[0]
+	if strings.ContainsAny(newPath, " \t\"") {
+		return failure.User(fmt.Errorf("invalid module path %q", newPath), "")
+	}
+
+	current, err := utils.ReadModulePath(*projectPath)
+	if err != nil {
+		return err
+	}
+
+	oldPath := *from
+	if oldPath == "" {
+		oldPath = current
+		if projectMetadata, err := metadata.LoadMetadata(*projectPath); err == nil && projectMetadata.Project.ModulePath != "" {
+			oldPath = projectMetadata.Project.ModulePath
+		}
+	}
+
+	imports, err := scanImports(*projectPath)
+	if err != nil {
+		return err
+	}
+
+	files := make(map[string]bool)
+	if oldPath != newPath {
+		for _, imp := range imports {
+			if inModule(imp.Path, oldPath) {
+				files[imp.File] = true
+			}
+		}
+	}
+
+	if current == newPath && len(files) == 0 {
+		fmt.Fprintf(out, "✅ Module path is already %s\n", newPath)
+		return recordRenamedModule(*projectPath, newPath, *dryRun)
+	}
+
+	if current != newPath {
+		fmt.Fprintf(out, "go.mod: module %s → %s\n", current, newPath)
+	}
+	if len(files) > 0 {
+		fmt.Fprintf(out, "Imports of %s → %s in %d file(s):\n", oldPath, newPath, len(files))
+		for _, file := range sortedKeys(files) {
+			fmt.Fprintf(out, "  %s\n", file)
+		}
+	}
+
+	if *dryRun {
+		diff, err := renameModuleDiff(*projectPath, current != newPath, sortedKeys(files), oldPath, newPath)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintln(out)
+		writeDiff(out, diff)
+		fmt.Fprintln(out, "Dry run: no files were changed")
+		return nil
+	}
+
+	if current != newPath {
+		if err := rewriteModuleDirective(*projectPath, newPath); err != nil {
+			return err
+		}
+	}
+	for _, file := range sortedKeys(files) {
+		if err := rewriteImports(filepath.Join(*projectPath, file), oldPath, newPath); err != nil {
+			return err
+		}
+	}
+
+	if err := recordRenamedModule(*projectPath, newPath, false); err != nil {
+		return err
+	}
+
+	fmt.Fprintf(out, "✅ Renamed module to %s\n", newPath)
+	return nil
+}
+
+// recordRenamedModule caches the module path in gophex.md, when the project has one
+func recordRenamedModule(projectPath, modulePath string, dryRun bool) error {
+	if dryRun || !utils.HasGophexMetadata(projectPath) {
+		return nil
+	}
+	if err := metadata.SetModulePath(projectPath, modulePath); err != nil {
+		return fmt.Errorf("failed to record module path in gophex.md: %w", err)
+	}
+	return nil
+}
+
+// rewriteModuleDirective replaces the module path in go.mod
+func rewriteModuleDirective(projectPath, modulePath string) error {
+	goModPath := filepath.Join(projectPath, "go.mod")
+	content, err := os.ReadFile(goModPath)
+	if err != nil {
+		return fmt.Errorf("failed to read go.mod: %w", err)
+	}
+
+	if err := os.WriteFile(goModPath, replaceModuleDirective(content, modulePath), 0644); err != nil {
+		return fmt.Errorf("failed to write go.mod: %w", err)
+	}
+	return nil
+}
+
+// replaceModuleDirective returns go.mod content with its module path set to modulePath
+func replaceModuleDirective(content []byte, modulePath string) []byte {
+	return moduleLinePattern.ReplaceAllLiteral(content, []byte("module "+modulePath))
+}
+
+// renameModuleDiff shows the go.mod and import lines a rename would change
+func renameModuleDiff(projectPath string, renameDirective bool, files []string, oldPath, newPath string) (string, error) {
+	var diff strings.Builder
+	if renameDirective {
+		content, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+		if err != nil {
+			return "", fmt.Errorf("failed to read go.mod: %w", err)
+		}
+		diff.WriteString(lineDiff("go.mod", content, replaceModuleDirective(content, newPath)))
+	}
+
+	for _, file := range files {
+		path := filepath.Join(projectPath, file)
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		updated, err := replaceImports(path, content, oldPath, newPath)
+		if err != nil {
+			return "", err
+		}
+		diff.WriteString(lineDiff(filepath.ToSlash(file), content, updated))
+	}
+	return diff.String(), nil
+}
+
+// rewriteImports moves the imports of oldPath and its packages to newPath, leaving the rest of the file untouched
+func rewriteImports(path, oldPath, newPath string) error {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("failed to read %s: %w", path, err)
+	}
+
+	content, err = replaceImports(path, content, oldPath, newPath)
+	if err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(path, content, 0644); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return nil
+}
+
+// replaceImports returns a copy of content with the imports of oldPath and its packages moved to newPath
+func replaceImports(path string, content []byte, oldPath, newPath string) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, path, content, parser.ImportsOnly)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+	content = append([]byte(nil), content...)
+
+	// Replace from the end so earlier offsets stay valid
+	specs := file.Imports
+	sort.Slice(specs, func(i, j int) bool { return specs[i].Path.Pos() > specs[j].Path.Pos() })
+
+	for _, spec := range specs {
+		importPath, err := strconv.Unquote(spec.Path.Value)
+		if err != nil || !inModule(importPath, oldPath) {
+			continue
+		}
+
+		start := fset.Position(spec.Path.Pos()).Offset
+		end := fset.Position(spec.Path.End()).Offset
+		replacement := strconv.Quote(newPath + strings.TrimPrefix(importPath, oldPath))
+		content = append(content[:start], append([]byte(replacement), content[end:]...)...)
+	}
+	return content, nil
+}
@@ -0,0 +1,136 @@
+package cmd
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/testutil"
+)
+
+// writeModuleProject creates a project for module with gophex.md and one file importing its internal packages
+func writeModuleProject(t *testing.T, module, importRoot string) string {
+	t.Helper()
+	projectMetadata := &metadata.ProjectMetadata{Project: metadata.ProjectInfo{Name: "shop", Type: "api"}}
+
+	return testutil.WriteProject(t, projectMetadata, map[string]string{
+		"go.mod":          "module " + module + "\n\ngo 1.22\n",
+		"cmd/api/main.go": "package main\n\nimport (\n\t\"fmt\"\n\n\t\"" + importRoot + "/internal/config\"\n\tdb \"" + importRoot + "/internal/database\"\n)\n\nfunc main() { fmt.Println(config.Load(), db.Open) }\n",
+	})
+}
+
+func TestResolveModulePath(t *testing.T) {
+	t.Run("caches module path", func(t *testing.T) {
+		projectPath := writeModuleProject(t, "shop", "shop")
+
+		modulePath, err := resolveModulePath(projectPath)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if modulePath != "shop" {
+			t.Errorf("Expected module shop, got %q", modulePath)
+		}
+
+		projectMetadata, err := metadata.LoadMetadata(projectPath)
+		if err != nil {
+			t.Fatalf("Failed to load metadata: %v", err)
+		}
+		if projectMetadata.Project.ModulePath != "shop" {
+			t.Errorf("Expected module path to be cached in gophex.md, got %q", projectMetadata.Project.ModulePath)
+		}
+	})
+
+	t.Run("go.mod renamed", func(t *testing.T) {
+		projectPath := writeModuleProject(t, "shop", "shop")
+		if err := metadata.SetModulePath(projectPath, "store"); err != nil {
+			t.Fatalf("Failed to record module path: %v", err)
+		}
+
+		_, err := resolveModulePath(projectPath)
+		if err == nil || !strings.Contains(err.Error(), "gophex rename-module shop --path") {
+			t.Errorf("Expected mismatch error offering rename-module, got %v", err)
+		}
+	})
+
+	t.Run("stale imports", func(t *testing.T) {
+		projectPath := writeModuleProject(t, "shop", "github.com/acme/store")
+
+		_, err := resolveModulePath(projectPath)
+		if err == nil || !strings.Contains(err.Error(), "--from github.com/acme/store") {
+			t.Errorf("Expected stale import error offering rename-module, got %v", err)
+		}
+	})
+}
+
+func TestResolveModulePathSkipsUnparsableFiles(t *testing.T) {
+	projectPath := writeModuleProject(t, "shop", "shop")
+	broken := filepath.Join(projectPath, "internal", "legacy", "broken.go")
+	if err := os.MkdirAll(filepath.Dir(broken), 0755); err != nil {
+		t.Fatalf("Failed to create directory: %v", err)
+	}
+	if err := os.WriteFile(broken, []byte("package legacy\n\nimport (\n\t\"fmt\"\n"), 0644); err != nil {
+		t.Fatalf("Failed to write broken.go: %v", err)
+	}
+
+	modulePath, err := resolveModulePath(projectPath)
+	if err != nil {
+		t.Fatalf("Expected the broken file to be skipped, got %v", err)
+	}
+	if modulePath != "shop" {
+		t.Errorf("Expected module shop, got %q", modulePath)
+	}
+}
+
+func TestRunRenameModule(t *testing.T) {
+	projectPath := writeModuleProject(t, "shop", "shop")
+
+	var out bytes.Buffer
+	if err := runRenameModule([]string{"github.com/acme/shop", "--dry-run", "--path", projectPath}, &out); err != nil {
+		t.Fatalf("Dry run failed: %v", err)
+	}
+	if !strings.Contains(out.String(), filepath.Join("cmd", "api", "main.go")) {
+		t.Errorf("Expected dry run to list main.go, got:\n%s", out.String())
+	}
+	highlighted := renderCodeBlocks(out.String(), true)
+	for _, want := range []string{
+		ansiDim + "```diff" + ansiReset,
+		ansiRed + "-module shop" + ansiReset,
+		ansiGreen + "+module github.com/acme/shop" + ansiReset,
+		ansiDim + "--- a/cmd/api/main.go" + ansiReset,
+		ansiGreen + "+\t\"github.com/acme/shop/internal/config\"" + ansiReset,
+		ansiGreen + "+\tdb \"github.com/acme/shop/internal/database\"" + ansiReset,
+	} {
+		if !strings.Contains(highlighted, want) {
+			t.Errorf("Expected the dry run diff to contain %q, got:\n%s", want, highlighted)
+		}
+	}
+	if modulePath, _ := resolveModulePath(projectPath); modulePath != "shop" {
+		t.Errorf("Expected dry run to leave the module unchanged, got %q", modulePath)
+	}
+
+	out.Reset()
+	if err := runRenameModule([]string{"github.com/acme/shop", "--path", projectPath}, &out); err != nil {
+		t.Fatalf("Rename failed: %v", err)
+	}
+
+	main, err := os.ReadFile(filepath.Join(projectPath, "cmd", "api", "main.go"))
+	if err != nil {
+		t.Fatalf("Failed to read main.go: %v", err)
+	}
+	for _, want := range []string{`"fmt"`, `"github.com/acme/shop/internal/config"`, `db "github.com/acme/shop/internal/database"`} {
+		if !strings.Contains(string(main), want) {
+			t.Errorf("Expected main.go to contain %s, got:\n%s", want, main)
+		}
+	}
+
+	modulePath, err := resolveModulePath(projectPath)
+	if err != nil {
+		t.Fatalf("Expected a consistent project after rename, got %v", err)
+	}
+	if modulePath != "github.com/acme/shop" {
+		t.Errorf("Expected module github.com/acme/shop, got %q", modulePath)
+	}
+}
@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+)
+
+// platform captures the operating-system specific choices Gophex makes when it runs
+// project scripts or opens files, so they can be exercised for any OS in tests
+type platform struct {
+	goos     string
+	lookPath func(file string) (string, error)
+}
+
+// currentPlatform returns the platform Gophex is running on
+func currentPlatform() platform {
+	return platform{goos: runtime.GOOS, lookPath: exec.LookPath}
+}
+
+// isWindows reports whether the platform is Windows
+func (p platform) isWindows() bool {
+	return p.goos == "windows"
+}
+
+// scriptNames returns the candidate file names for a project script, preferred first.
+// Windows prefers the native batch file and falls back to the shell script when bash
+// (Git Bash, MSYS2 or WSL) is on the PATH.
+func (p platform) scriptNames(base string) []string {
+	if p.isWindows() {
+		names := []string{base + ".bat"}
+		if _, err := p.lookPath("bash"); err == nil {
+			names = append(names, base+".sh")
+		}
+		return names
+	}
+	return []string{base + ".sh"}
+}
+
+// findScript locates scripts/<base>.bat or scripts/<base>.sh in a project
+func (p platform) findScript(projectPath, base string) (string, error) {
+	names := p.scriptNames(base)
+	for _, name := range names {
+		scriptPath := filepath.Join(projectPath, "scripts", name)
+		if _, err := os.Stat(scriptPath); err == nil {
+			return scriptPath, nil
+		}
+	}
+
+	return "", fmt.Errorf("%s script not found: %s", base, filepath.Join(projectPath, "scripts", names[0]))
+}
+
+// scriptCommand returns the program and arguments that run a project script
+func (p platform) scriptCommand(scriptPath string, args ...string) (string, []string) {
+	if filepath.Ext(scriptPath) == ".bat" {
+		return "cmd", append([]string{"/c", scriptPath}, args...)
+	}
+	return "bash", append([]string{scriptPath}, args...)
+}
+
+// shellCommand returns the program and arguments that run a command line through the system shell
+func (p platform) shellCommand(command string) (string, []string) {
+	if p.isWindows() {
+		return "cmd", []string{"/c", command}
+	}
+	return "sh", []string{"-c", command}
+}
+
+// prepareScript makes a shell script executable; Windows has no executable bit
+func (p platform) prepareScript(scriptPath string) error {
+	if p.isWindows() || filepath.Ext(scriptPath) != ".sh" {
+		return nil
+	}
+	return os.Chmod(scriptPath, 0755)
+}
+
+// fileManagerCommand returns the program and arguments that open a directory in the system file manager
+func (p platform) fileManagerCommand(path string) (string, []string, error) {
+	switch p.goos {
+	case "darwin":
+		return "open", []string{path}, nil
+	case "windows":
+		return "explorer", []string{filepath.Clean(path)}, nil
+	case "linux", "freebsd", "openbsd", "netbsd":
+		return "xdg-open", []string{path}, nil
+	default:
+		return "", nil, fmt.Errorf("unsupported operating system: %s", p.goos)
+	}
+}
+
+// scriptDisplayPath returns how users should invoke a project script from the project root
+func (p platform) scriptDisplayPath(base string) string {
+	if p.isWindows() {
+		return `scripts\` + base + ".bat"
+	}
+	return "./scripts/" + base + ".sh"
+}
+
+// mainFilePath returns the entry point of a generated project, relative to its root
+func mainFilePath(projectType string) (string, error) {
+	switch projectType {
+	case "api":
+		return filepath.Join("cmd", "api", "main.go"), nil
+	case "webapp":
+		return filepath.Join("cmd", "webapp", "main.go"), nil
+	case "microservice":
+		return filepath.Join("cmd", "server", "main.go"), nil
+	case "cli":
+		return filepath.Join("cmd", "main.go"), nil
+	default:
+		return "", fmt.Errorf("unsupported project type: %s", projectType)
+	}
+}
@@ -0,0 +1,215 @@
+package cmd
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"testing"
+)
+
+// fakeLookPath simulates which tools are on the PATH
+func fakeLookPath(available ...string) func(string) (string, error) {
+	return func(file string) (string, error) {
+		for _, name := range available {
+			if name == file {
+				return filepath.Join("bin", file), nil
+			}
+		}
+		return "", errors.New("executable file not found")
+	}
+}
+
+// writeScripts creates the given files in a temporary project's scripts directory
+func writeScripts(t *testing.T, names ...string) string {
+	t.Helper()
+	projectPath := t.TempDir()
+	scriptsDir := filepath.Join(projectPath, "scripts")
+	if err := os.MkdirAll(scriptsDir, 0755); err != nil {
+		t.Fatalf("Failed to create scripts directory: %v", err)
+	}
+	for _, name := range names {
+		if err := os.WriteFile(filepath.Join(scriptsDir, name), []byte("echo ok\n"), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+	return projectPath
+}
+
+func TestFindScript(t *testing.T) {
+	tests := []struct {
+		name     string
+		goos     string
+		tools    []string
+		scripts  []string
+		expected string
+	}{
+		{"linux uses shell script", "linux", nil, []string{"migrate.sh", "migrate.bat"}, "migrate.sh"},
+		{"darwin uses shell script", "darwin", nil, []string{"migrate.sh", "migrate.bat"}, "migrate.sh"},
+		{"windows prefers batch file", "windows", []string{"bash"}, []string{"migrate.sh", "migrate.bat"}, "migrate.bat"},
+		{"windows falls back to bash", "windows", []string{"bash"}, []string{"migrate.sh"}, "migrate.sh"},
+		{"windows without bash", "windows", nil, []string{"migrate.sh"}, ""},
+		{"linux never runs batch files", "linux", nil, []string{"migrate.bat"}, ""},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			projectPath := writeScripts(t, test.scripts...)
+			host := platform{goos: test.goos, lookPath: fakeLookPath(test.tools...)}
+
+			scriptPath, err := host.findScript(projectPath, "migrate")
+			if test.expected == "" {
+				if err == nil {
+					t.Errorf("Expected an error, got %s", scriptPath)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Expected %s, got error: %v", test.expected, err)
+			}
+			if filepath.Base(scriptPath) != test.expected {
+				t.Errorf("Expected %s, got %s", test.expected, filepath.Base(scriptPath))
+			}
+			if filepath.Dir(scriptPath) != filepath.Join(projectPath, "scripts") {
+				t.Errorf("Expected script inside the project's scripts directory, got %s", scriptPath)
+			}
+		})
+	}
+}
+
+func TestScriptCommand(t *testing.T) {
+	tests := []struct {
+		script       string
+		expectedName string
+		expectedArgs []string
+	}{
+		{`C:\Users\dev\My Projects\api\scripts\migrate.bat`, "cmd", []string{"/c", `C:\Users\dev\My Projects\api\scripts\migrate.bat`, "up"}},
+		{"/home/dev/api/scripts/migrate.sh", "bash", []string{"/home/dev/api/scripts/migrate.sh", "up"}},
+		{`C:\Users\dev\api\scripts\migrate.sh`, "bash", []string{`C:\Users\dev\api\scripts\migrate.sh`, "up"}},
+	}
+
+	host := platform{goos: "windows", lookPath: fakeLookPath()}
+	for _, test := range tests {
+		t.Run(test.script, func(t *testing.T) {
+			name, args := host.scriptCommand(test.script, "up")
+			if name != test.expectedName {
+				t.Errorf("Expected %s, got %s", test.expectedName, name)
+			}
+			if !reflect.DeepEqual(args, test.expectedArgs) {
+				t.Errorf("Expected %v, got %v", test.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestShellCommand(t *testing.T) {
+	tests := []struct {
+		goos         string
+		expectedName string
+		expectedArgs []string
+	}{
+		{"linux", "sh", []string{"-c", "make lint && go vet ./..."}},
+		{"darwin", "sh", []string{"-c", "make lint && go vet ./..."}},
+		{"windows", "cmd", []string{"/c", "make lint && go vet ./..."}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.goos, func(t *testing.T) {
+			host := platform{goos: test.goos, lookPath: fakeLookPath()}
+			name, args := host.shellCommand("make lint && go vet ./...")
+			if name != test.expectedName {
+				t.Errorf("Expected %s, got %s", test.expectedName, name)
+			}
+			if !reflect.DeepEqual(args, test.expectedArgs) {
+				t.Errorf("Expected %v, got %v", test.expectedArgs, args)
+			}
+		})
+	}
+}
+
+func TestFileManagerCommand(t *testing.T) {
+	tests := []struct {
+		goos         string
+		expectedName string
+		expectError  bool
+	}{
+		{"windows", "explorer", false},
+		{"darwin", "open", false},
+		{"linux", "xdg-open", false},
+		{"freebsd", "xdg-open", false},
+		{"plan9", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.goos, func(t *testing.T) {
+			host := platform{goos: test.goos, lookPath: fakeLookPath()}
+			name, args, err := host.fileManagerCommand(filepath.Join("projects", "api"))
+			if test.expectError {
+				if err == nil {
+					t.Error("Expected an error for an unsupported operating system")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if name != test.expectedName {
+				t.Errorf("Expected %s, got %s", test.expectedName, name)
+			}
+			if len(args) != 1 || args[0] != filepath.Join("projects", "api") {
+				t.Errorf("Expected the project path as the only argument, got %v", args)
+			}
+		})
+	}
+}
+
+func TestPrepareScript(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("file permissions are not observable on Windows")
+	}
+
+	projectPath := writeScripts(t, "migrate.sh", "migrate.bat")
+	shellScript := filepath.Join(projectPath, "scripts", "migrate.sh")
+	batchScript := filepath.Join(projectPath, "scripts", "migrate.bat")
+
+	// Windows never touches permissions
+	windows := platform{goos: "windows", lookPath: fakeLookPath()}
+	if err := windows.prepareScript(shellScript); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if info, _ := os.Stat(shellScript); info.Mode().Perm()&0111 != 0 {
+		t.Error("Expected script permissions to be left alone on Windows")
+	}
+
+	linux := platform{goos: "linux", lookPath: fakeLookPath()}
+	for _, script := range []string{shellScript, batchScript} {
+		if err := linux.prepareScript(script); err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+	}
+	if info, _ := os.Stat(shellScript); info.Mode().Perm()&0111 == 0 {
+		t.Error("Expected shell script to be executable")
+	}
+	if info, _ := os.Stat(batchScript); info.Mode().Perm()&0111 != 0 {
+		t.Error("Expected batch file permissions to be left alone")
+	}
+}
+
+func TestScriptDisplayPath(t *testing.T) {
+	tests := []struct {
+		goos     string
+		expected string
+	}{
+		{"windows", `scripts\migrate.bat`},
+		{"linux", "./scripts/migrate.sh"},
+		{"darwin", "./scripts/migrate.sh"},
+	}
+
+	for _, test := range tests {
+		host := platform{goos: test.goos, lookPath: fakeLookPath()}
+		if result := host.scriptDisplayPath("migrate"); result != test.expected {
+			t.Errorf("%s: expected %s, got %s", test.goos, test.expected, result)
+		}
+	}
+}
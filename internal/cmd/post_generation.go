@@ -2,6 +2,8 @@ package cmd
 
 import (
 	"fmt"
+	"os"
+	"path/filepath"
 
 	"github.com/AlecAivazis/survey/v2"
 	"github.com/buildwithhp/gophex/internal/utils"
@@ -46,7 +48,7 @@ func ShowPostGenerationMenu(opts PostGenerationOptions) error {
 			Options: buildMenuOptions(tracker),
 		}
 
-		err := survey.AskOne(menuPrompt, &choice)
+		err := askOne(menuPrompt, &choice)
 		if err != nil {
 			// Handle user interruption (Ctrl+C) gracefully
 			if isUserInterrupt(err) {
@@ -58,16 +60,18 @@ func ShowPostGenerationMenu(opts PostGenerationOptions) error {
 
 		// Handle the selected option
 		switch {
+		case choice[:4] == "🧭":
+			if err := RunFirstRun(opts.ProjectPath, opts.ProjectType); err != nil {
+				fmt.Printf("❌ First run failed: %v\n", err)
+			}
+			if err := tracker.LoadMetadata(); err != nil {
+				fmt.Printf("⚠️  Warning: Could not reload project metadata: %v\n", err)
+			}
 		case choice[:2] == "⚡":
 			if err := RunQuickStart(opts.ProjectPath, opts.ProjectType); err != nil {
 				fmt.Printf("❌ Quick start failed: %v\n", err)
 			} else {
-				// Quick start includes multiple activities - update both systems
-				if utils.HasGophexMetadata(opts.ProjectPath) {
-					utils.UpdateActivity(opts.ProjectPath, "dependencies_installed", true)
-					utils.UpdateActivity(opts.ProjectPath, "database_migrated", true)
-					utils.UpdateActivity(opts.ProjectPath, "application_started", true)
-				}
+				// Quick start includes multiple activities
 				tracker.UpdateActivity("dependencies_installed", true)
 				tracker.UpdateActivity("database_setup", true)
 				tracker.UpdateActivity("application_started", true)
@@ -76,13 +80,7 @@ func ShowPostGenerationMenu(opts PostGenerationOptions) error {
 			if err := RunDevelopmentWorkflow(opts.ProjectPath, opts.ProjectType); err != nil {
 				fmt.Printf("❌ Development workflow failed: %v\n", err)
 			} else {
-				// Development workflow includes all activities - update both systems
-				if utils.HasGophexMetadata(opts.ProjectPath) {
-					utils.UpdateActivity(opts.ProjectPath, "dependencies_installed", true)
-					utils.UpdateActivity(opts.ProjectPath, "database_migrated", true)
-					utils.UpdateActivity(opts.ProjectPath, "application_started", true)
-					utils.UpdateActivity(opts.ProjectPath, "tests_executed", true)
-				}
+				// Development workflow includes all activities
 				tracker.UpdateActivity("dependencies_installed", true)
 				tracker.UpdateActivity("database_setup", true)
 				tracker.UpdateActivity("application_started", true)
@@ -118,12 +116,20 @@ func ShowPostGenerationMenu(opts PostGenerationOptions) error {
 			} else {
 				tracker.UpdateActivity("tests_run", true)
 			}
+		case choice[:4] == "💨":
+			if err := RunSmokeTests(opts.ProjectPath, opts.ProjectType); err != nil {
+				fmt.Printf("❌ Smoke tests failed: %v\n", err)
+			}
 		case choice[:4] == "📖":
 			if err := ViewDocumentation(opts.ProjectPath); err != nil {
 				fmt.Printf("❌ Error viewing documentation: %v\n", err)
 			} else {
 				tracker.UpdateActivity("documentation_viewed", true)
 			}
+		case choice[:4] == "📜":
+			if err := ViewApplicationLogs(opts.ProjectPath); err != nil {
+				fmt.Printf("❌ Error viewing logs: %v\n", err)
+			}
 		case choice[:4] == "🔍":
 			if err := RunChangeDetection(opts.ProjectPath); err != nil {
 				fmt.Printf("❌ Change detection failed: %v\n", err)
@@ -165,7 +171,7 @@ func ShowPostGenerationMenu(opts PostGenerationOptions) error {
 			},
 		}
 
-		err = survey.AskOne(continuePrompt, &continueMenu)
+		err = askOne(continuePrompt, &continueMenu)
 		if err != nil {
 			// Handle user interruption (Ctrl+C) gracefully
 			if isUserInterrupt(err) {
@@ -187,6 +193,7 @@ func buildMenuOptions(tracker *ProjectTracker) []string {
 	projectPath := tracker.projectPath
 
 	options := []string{
+		fmt.Sprintf("🧭 %sFirst run - guided setup in the right order", utils.GetActivityPrefix(projectPath, firstRunActivity)),
 		"⚡ Quick start (install deps + start app)",
 		"🔄 Development workflow (full auto-setup)",
 		"📁 Open project directory",
@@ -198,7 +205,7 @@ func buildMenuOptions(tracker *ProjectTracker) []string {
 
 		// Add database-specific options if database is configured
 		metadata := tracker.GetMetadata()
-		if metadata.Gophex.Database.Configured {
+		if metadata.Database.Configured {
 			prefix := utils.GetActivityPrefix(projectPath, "database_migrated")
 			options = append(options, fmt.Sprintf("🗄️  %sRun database migrations/initialization", prefix))
 		}
@@ -235,7 +242,7 @@ func buildMenuOptions(tracker *ProjectTracker) []string {
 	} else {
 		// Fallback to old system
 		metadata := tracker.GetMetadata()
-		if metadata.Gophex.Database.Configured {
+		if metadata.Database.Configured {
 			prefix := tracker.GetActivityPrefix("database_setup")
 			options = append(options, fmt.Sprintf("🗄️  %sRun database migrations/initialization", prefix))
 		}
@@ -262,7 +269,7 @@ func buildMenuOptions(tracker *ProjectTracker) []string {
 
 		// Add CRUD generation option (only for API projects)
 		trackerMetadata := tracker.GetMetadata()
-		if trackerMetadata.Gophex.Project.Type == "api" {
+		if trackerMetadata.Project.Type == "api" {
 			prefix = tracker.GetActivityPrefix("crud_generated")
 			options = append(options, fmt.Sprintf("🏗️  %sGenerate CRUD operations", prefix))
 
@@ -272,6 +279,18 @@ func buildMenuOptions(tracker *ProjectTracker) []string {
 		}
 	}
 
+	// Offer smoke tests for API projects generated with scripts/smoke.go
+	if tracker.GetMetadata().Project.Type == "api" {
+		if _, err := os.Stat(filepath.Join(projectPath, "scripts", "smoke.go")); err == nil {
+			options = append(options, "💨 Run smoke tests")
+		}
+	}
+
+	// Offer the log viewer once an application has been started from Gophex
+	if GetProcessManager().LogsFor(projectPath) != nil {
+		options = append(options, "📜 View application logs")
+	}
+
 	// Add static options
 	options = append(options,
 		"🆕 Generate another project",
@@ -0,0 +1,30 @@
+package cmd
+
+import "syscall"
+
+const (
+	// processQueryLimitedInformation is the least access right that allows GetExitCodeProcess
+	processQueryLimitedInformation = 0x1000
+	// stillActive is the exit code Windows reports for a process that has not exited
+	stillActive = 259
+)
+
+// processAlive reports whether a process with the given PID is still running. Opening the
+// process is not enough on Windows: a handle can be opened for a process that already exited.
+func processAlive(pid int) bool {
+	if pid <= 0 {
+		return false
+	}
+
+	handle, err := syscall.OpenProcess(processQueryLimitedInformation, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	defer syscall.CloseHandle(handle)
+
+	var code uint32
+	if err := syscall.GetExitCodeProcess(handle, &code); err != nil {
+		return false
+	}
+	return code == stillActive
+}
@@ -8,11 +8,13 @@ import (
 	"syscall"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/buildwithhp/gophex/internal/registry"
 )
 
 // ProcessManager tracks and manages child processes started by Gophex
 type ProcessManager struct {
 	processes map[string]*ProcessInfo
+	logs      map[string]*LogBuffer
 	mutex     sync.RWMutex
 }
 
@@ -26,6 +28,7 @@ type ProcessInfo struct {
 
 var globalProcessManager = &ProcessManager{
 	processes: make(map[string]*ProcessInfo),
+	logs:      make(map[string]*LogBuffer),
 }
 
 // GetProcessManager returns the global process manager instance
@@ -104,7 +107,7 @@ func (pm *ProcessManager) HandleGracefulShutdown() error {
 		},
 	}
 
-	err := survey.AskOne(shutdownPrompt, &action)
+	err := askOne(shutdownPrompt, &action)
 	if err != nil {
 		if isUserInterrupt(err) {
 			// Force terminate on interrupt
@@ -167,12 +170,53 @@ func (pm *ProcessManager) StartProcessWithTracking(name, description, projectPat
 	}
 
 	pm.AddProcess(name, description, projectPath, cmd)
+	recordPID(projectPath, cmd.Process.Pid)
 
 	// Start a goroutine to clean up when process exits
 	go func() {
-		cmd.Wait()
+		err := cmd.Wait()
+		if writer, ok := cmd.Stdout.(*logWriter); ok {
+			writer.buffer.Finish(err)
+		}
 		pm.RemoveProcess(name)
+		recordPID(projectPath, 0)
 	}()
 
 	return nil
 }
+
+// recordPID stores the application PID in the project registry for "gophex dashboard" (0 clears it)
+func recordPID(projectPath string, pid int) {
+	if err := registry.SetPID(projectPath, pid); err != nil {
+		fmt.Printf("⚠️  Warning: Could not record the application PID in the project registry: %v\n", err)
+	}
+}
+
+// StartProcessWithLogs starts a tracked process whose stdout and stderr are captured in logs
+// instead of being written to the wizard's terminal
+func (pm *ProcessManager) StartProcessWithLogs(name, description, projectPath string, cmd *exec.Cmd, logs *LogBuffer) error {
+	cmd.Stdout = logs.Writer(streamStdout)
+	cmd.Stderr = logs.Writer(streamStderr)
+
+	if err := pm.StartProcessWithTracking(name, description, projectPath, cmd); err != nil {
+		logs.Finish(err)
+		return err
+	}
+
+	pm.mutex.Lock()
+	if pm.logs == nil {
+		pm.logs = make(map[string]*LogBuffer)
+	}
+	pm.logs[projectPath] = logs
+	pm.mutex.Unlock()
+
+	return nil
+}
+
+// LogsFor returns the captured logs of the application most recently started for a project.
+// Logs remain available after the application exits.
+func (pm *ProcessManager) LogsFor(projectPath string) *LogBuffer {
+	pm.mutex.RLock()
+	defer pm.mutex.RUnlock()
+	return pm.logs[projectPath]
+}
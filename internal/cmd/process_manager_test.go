@@ -1,6 +1,7 @@
 package cmd
 
 import (
+	"os"
 	"os/exec"
 	"testing"
 	"time"
@@ -120,6 +121,7 @@ func TestProcessManager_HasRunningProcesses(t *testing.T) {
 }
 
 func TestProcessManager_StartProcessWithTracking(t *testing.T) {
+	t.Setenv("GOPHEX_HOME", t.TempDir())
 	pm := &ProcessManager{
 		processes: make(map[string]*ProcessInfo),
 	}
@@ -202,3 +204,20 @@ func TestGetProcessManager(t *testing.T) {
 		t.Error("GetProcessManager should return the same instance (singleton)")
 	}
 }
+
+func TestProcessAlive(t *testing.T) {
+	if !processAlive(os.Getpid()) {
+		t.Error("Expected the test process to be alive")
+	}
+	if processAlive(0) {
+		t.Error("Expected PID 0 not to be reported as alive")
+	}
+
+	cmd := exec.Command(os.Args[0], "-test.run=^$")
+	if err := cmd.Run(); err != nil {
+		t.Fatalf("Failed to run child process: %v", err)
+	}
+	if processAlive(cmd.Process.Pid) {
+		t.Errorf("Expected exited process %d not to be reported as alive", cmd.Process.Pid)
+	}
+}
@@ -1,8 +1,9 @@
 package cmd
 
 import (
-	"bufio"
+	"errors"
 	"fmt"
+	goversion "go/version"
 	"io"
 	"net/http"
 	"os"
@@ -13,32 +14,36 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/utils"
 )
 
 // OpenProjectDirectory opens the project directory in the system file manager
 func OpenProjectDirectory(projectPath string) error {
 	fmt.Printf("📁 Opening project directory: %s\n", projectPath)
 
-	var cmd *exec.Cmd
-	switch runtime.GOOS {
-	case "darwin":
-		cmd = exec.Command("open", projectPath)
-	case "linux":
-		cmd = exec.Command("xdg-open", projectPath)
-	case "windows":
-		cmd = exec.Command("explorer", projectPath)
-	default:
-		return fmt.Errorf("unsupported operating system: %s", runtime.GOOS)
+	host := currentPlatform()
+	name, args, err := host.fileManagerCommand(projectPath)
+	if err != nil {
+		return err
 	}
 
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("failed to open directory: %w", err)
+	if err := exec.Command(name, args...).Run(); err != nil {
+		// explorer.exe exits with status 1 even when the window opened successfully
+		var exitErr *exec.ExitError
+		if !host.isWindows() || !errors.As(err, &exitErr) {
+			return fmt.Errorf("failed to open directory: %w", err)
+		}
 	}
 
 	fmt.Println("✅ Project directory opened successfully")
 	return nil
 }
 
+// databaseReachableHint is shown when migrations fail, most often because the database is not running
+const databaseReachableHint = "check that the database in .env is running and reachable; the guided First run can start a local one"
+
 // RunDatabaseSetup runs database migrations or initialization based on project type
 func RunDatabaseSetup(projectPath, projectType string) error {
 	if projectType != "api" {
@@ -55,10 +60,8 @@ func RunDatabaseSetup(projectPath, projectType string) error {
 	}
 
 	// Make script executable (Unix/Linux/macOS only)
-	if runtime.GOOS != "windows" {
-		if err := os.Chmod(migrateScript, 0755); err != nil {
-			return fmt.Errorf("failed to make migrate script executable: %w", err)
-		}
+	if err := currentPlatform().prepareScript(migrateScript); err != nil {
+		return fmt.Errorf("failed to make migrate script executable: %w", err)
 	}
 
 	// Change to project directory
@@ -116,10 +119,10 @@ func RunDatabaseSetup(projectPath, projectType string) error {
 			// Retry the migration
 			fmt.Println("🔄 Retrying database setup...")
 			if retryErr := cmd.Run(); retryErr != nil {
-				return fmt.Errorf("database setup failed after installing migration tool: %w", retryErr)
+				return failure.Tool(fmt.Errorf("database setup failed after installing migration tool: %w", retryErr), databaseReachableHint)
 			}
 		} else {
-			return fmt.Errorf("database setup failed: %w", err)
+			return failure.Tool(fmt.Errorf("database setup failed: %w", err), databaseReachableHint)
 		}
 	}
 
@@ -142,19 +145,42 @@ func InstallDependencies(projectPath string) error {
 		return fmt.Errorf("failed to change to project directory: %w", err)
 	}
 
+	selectedGo := goDirective("go.mod")
+
 	// Run go mod tidy
 	cmd := exec.Command("go", "mod", "tidy")
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
 
 	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("go mod tidy failed: %w", err)
+		return failure.Tool(fmt.Errorf("go mod tidy failed: %w", err), "check your network connection and the module errors above, then retry")
+	}
+
+	// tidy raises the go directive when a dependency needs a newer release than was selected
+	if resolvedGo := goDirective("go.mod"); selectedGo != "" && goversion.Compare("go"+resolvedGo, "go"+selectedGo) > 0 {
+		fmt.Printf("⚠️  go.mod now requires Go %s instead of %s because a dependency needs it\n", resolvedGo, selectedGo)
+		fmt.Println("   💡 Pin older dependency versions if the project must build with the original release")
 	}
 
 	fmt.Println("✅ Dependencies installed successfully")
 	return nil
 }
 
+// goDirective returns the version in a go.mod file's go directive, or "" if it cannot be read
+func goDirective(goModPath string) string {
+	content, err := os.ReadFile(goModPath)
+	if err != nil {
+		return ""
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		if version, ok := strings.CutPrefix(strings.TrimSpace(line), "go "); ok {
+			return strings.TrimSpace(version)
+		}
+	}
+	return ""
+}
+
 // StartApplication starts the generated application
 func StartApplication(projectPath, projectType string) error {
 	fmt.Println("🚀 Starting application...")
@@ -171,7 +197,7 @@ func StartApplication(projectPath, projectType string) error {
 			},
 		}
 
-		if err := survey.AskOne(installPrompt, &installChoice); err != nil {
+		if err := askOne(installPrompt, &installChoice); err != nil {
 			if isUserInterrupt(err) {
 				return GetProcessManager().HandleGracefulShutdown()
 			}
@@ -194,6 +220,11 @@ func StartApplication(projectPath, projectType string) error {
 		}
 	}
 
+	// pre_start hooks gate the start, e.g. to lint or migrate first
+	if err := runProjectHooks(projectPath, metadata.HookPreStart, nil, os.Stdout); err != nil {
+		return fmt.Errorf("application not started: %w", err)
+	}
+
 	// Change to project directory
 	originalDir, err := os.Getwd()
 	if err != nil {
@@ -206,18 +237,9 @@ func StartApplication(projectPath, projectType string) error {
 	}
 
 	// Determine the main file based on project type
-	var mainFile string
-	switch projectType {
-	case "api":
-		mainFile = "cmd/api/main.go"
-	case "webapp":
-		mainFile = "cmd/webapp/main.go"
-	case "microservice":
-		mainFile = "cmd/server/main.go"
-	case "cli":
-		mainFile = "cmd/main.go"
-	default:
-		return fmt.Errorf("unsupported project type: %s", projectType)
+	mainFile, err := mainFilePath(projectType)
+	if err != nil {
+		return err
 	}
 
 	// Check if main file exists
@@ -226,25 +248,29 @@ func StartApplication(projectPath, projectType string) error {
 	}
 
 	fmt.Printf("🎯 Starting %s application...\n", projectType)
-	fmt.Println("📝 Application logs:")
-	fmt.Println("---")
 
 	// Start the application
 	cmd := exec.Command("go", "run", mainFile)
-	cmd.Stdout = os.Stdout
-	cmd.Stderr = os.Stderr
 
-	// Start the command with process tracking
+	// Start the command with process tracking, capturing its output for the log viewer
 	processName := fmt.Sprintf("%s-app", projectType)
 	processDesc := fmt.Sprintf("%s application", strings.Title(projectType))
 
+	logs, err := NewAppLogBuffer(projectPath, processName)
+	if err != nil {
+		return err
+	}
+
 	pm := GetProcessManager()
-	if err := pm.StartProcessWithTracking(processName, processDesc, projectPath, cmd); err != nil {
+	if err := pm.StartProcessWithLogs(processName, processDesc, projectPath, cmd, logs); err != nil {
 		return fmt.Errorf("failed to start application: %w", err)
 	}
 
-	// Give the application time to start
+	// Give the application time to start, then show its startup output
 	time.Sleep(2 * time.Second)
+	printLogTail(logs, startupLogLines)
+	fmt.Printf("📝 Full output is captured in %s\n", logs.Path())
+	fmt.Println("   Use \"View application logs\" from the menu to follow or filter it.")
 
 	// For API projects, show helpful information
 	if projectType == "api" {
@@ -273,7 +299,7 @@ func StartApplication(projectPath, projectType string) error {
 			},
 		}
 
-		if err := survey.AskOne(testPrompt, &testChoice); err != nil {
+		if err := askOne(testPrompt, &testChoice); err != nil {
 			if isUserInterrupt(err) {
 				return GetProcessManager().HandleGracefulShutdown()
 			}
@@ -324,26 +350,118 @@ func RunTests(projectPath string) error {
 	return nil
 }
 
-// ViewDocumentation displays the project documentation
+// RunSmokeTests calls every endpoint of the running API through the generated scripts/smoke.go
+func RunSmokeTests(projectPath, projectType string) error {
+	fmt.Println("💨 Running smoke tests...")
+
+	smokeScript := filepath.Join(projectPath, "scripts", "smoke.go")
+	if _, err := os.Stat(smokeScript); os.IsNotExist(err) {
+		return fmt.Errorf("smoke test script not found: %s", smokeScript)
+	}
+
+	if logs := GetProcessManager().LogsFor(projectPath); logs == nil || !logs.Running() {
+		var startChoice string
+		startPrompt := &survey.Select{
+			Message: "The application is not running from Gophex. What would you like to do?",
+			Options: []string{
+				"Start the application first",
+				"Continue - it is already running elsewhere",
+				"Back",
+			},
+		}
+
+		if err := askOne(startPrompt, &startChoice); err != nil {
+			if isUserInterrupt(err) {
+				return nil
+			}
+			return err
+		}
+
+		switch startChoice {
+		case "Back":
+			return nil
+		case "Start the application first":
+			if err := StartApplication(projectPath, projectType); err != nil {
+				return err
+			}
+		}
+	}
+
+	// Change to project directory so the script finds gophex.md and .env
+	originalDir, err := os.Getwd()
+	if err != nil {
+		return fmt.Errorf("failed to get current directory: %w", err)
+	}
+	defer os.Chdir(originalDir)
+
+	if err := os.Chdir(projectPath); err != nil {
+		return fmt.Errorf("failed to change to project directory: %w", err)
+	}
+
+	cmd := exec.Command("go", "run", filepath.Join("scripts", "smoke.go"))
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("smoke tests failed: %w", err)
+	}
+
+	return nil
+}
+
+// ViewDocumentation shows the project documentation in a pager with search and heading
+// navigation. Any generated doc can be opened: README.md, README_<entity>.md and docs/.
 func ViewDocumentation(projectPath string) error {
 	fmt.Println("📖 Viewing project documentation...")
 
-	readmePath := filepath.Join(projectPath, "README.md")
-	if _, err := os.Stat(readmePath); os.IsNotExist(err) {
+	documents, err := findProjectDocs(projectPath)
+	if err != nil {
+		return err
+	}
+	if len(documents) == 0 {
 		return fmt.Errorf("README.md not found in project")
 	}
 
-	// Read and display README content
-	content, err := os.ReadFile(readmePath)
-	if err != nil {
-		return fmt.Errorf("failed to read README.md: %w", err)
+	// Without someone at a terminal to page, print the README as a whole
+	if !canPage() {
+		content, err := os.ReadFile(filepath.Join(projectPath, documents[0]))
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", documents[0], err)
+		}
+		fmt.Printf("📄 %s:\n", documents[0])
+		fmt.Println("==================")
+		fmt.Println(string(content))
+		return nil
 	}
 
-	fmt.Println("📄 Project README:")
-	fmt.Println("==================")
-	fmt.Println(string(content))
+	first := documents[0]
+	if len(documents) > 1 {
+		if first, err = chooseDocument(documents); err != nil || first == "" {
+			return err
+		}
+	}
 
-	return nil
+	return pageDocuments(projectPath, first, documents, newTerminalPager(), chooseDocument)
+}
+
+// chooseDocument asks which document to open, returning "" to go back
+func chooseDocument(documents []string) (string, error) {
+	var document string
+	documentPrompt := &survey.Select{
+		Message: "Which document would you like to read?",
+		Options: append(append([]string{}, documents...), "Back"),
+	}
+
+	if err := askOne(documentPrompt, &document); err != nil {
+		if isUserInterrupt(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	if document == "Back" {
+		return "", nil
+	}
+	return document, nil
 }
 
 // RunChangeDetection runs the change detection script
@@ -358,10 +476,8 @@ func RunChangeDetection(projectPath string) error {
 	}
 
 	// Make script executable (Unix/Linux/macOS only)
-	if runtime.GOOS != "windows" {
-		if err := os.Chmod(scriptPath, 0755); err != nil {
-			return fmt.Errorf("failed to make script executable: %w", err)
-		}
+	if err := currentPlatform().prepareScript(scriptPath); err != nil {
+		return fmt.Errorf("failed to make script executable: %w", err)
 	}
 
 	// Change to project directory
@@ -389,28 +505,14 @@ func RunChangeDetection(projectPath string) error {
 
 // Helper functions
 
-// getDatabaseTypeFromMetadata reads the database type from the project metadata
+// getDatabaseTypeFromMetadata reads the database type from gophex.md, falling back to a legacy .gophex-generated file
 func getDatabaseTypeFromMetadata(projectPath string) (string, error) {
-	metadataPath := filepath.Join(projectPath, ".gophex-generated")
-	if _, err := os.Stat(metadataPath); os.IsNotExist(err) {
+	info, err := utils.ReadGenerationInfo(projectPath)
+	if err != nil || info.DatabaseType == "" {
 		return "postgresql", nil // Default fallback
 	}
 
-	file, err := os.Open(metadataPath)
-	if err != nil {
-		return "", err
-	}
-	defer file.Close()
-
-	scanner := bufio.NewScanner(file)
-	for scanner.Scan() {
-		line := scanner.Text()
-		if strings.HasPrefix(line, "database_type=") {
-			return strings.TrimPrefix(line, "database_type="), nil
-		}
-	}
-
-	return "postgresql", nil // Default fallback
+	return info.DatabaseType, nil
 }
 
 // ensureGolangMigrateInstalled checks if golang-migrate is installed and offers to install it
@@ -434,7 +536,7 @@ func ensureGolangMigrateInstalled(dbType string) error {
 		Help: "This will install the golang-migrate tool using 'go install'",
 	}
 
-	if err := survey.AskOne(installPrompt, &installMigrate); err != nil {
+	if err := askOne(installPrompt, &installMigrate); err != nil {
 		return err
 	}
 
@@ -444,8 +546,9 @@ func ensureGolangMigrateInstalled(dbType string) error {
 
 	if installMigrate[:2] == "No" {
 		fmt.Println("❌ Database migrations require golang-migrate tool")
-		fmt.Printf("   You can install it manually with: go install -tags '%s' github.com/golang-migrate/migrate/v4/cmd/migrate@latest\n", dbType)
-		return fmt.Errorf("golang-migrate tool is required but not installed")
+		fmt.Printf("   You can install it manually with: %s\n", utils.GolangMigrate.InstallCommand(utils.MigrateDriverTag(dbType)))
+		return failure.Tool(fmt.Errorf("golang-migrate tool is required but not installed"),
+			"install it with: "+utils.GolangMigrate.InstallCommand(utils.MigrateDriverTag(dbType)))
 	}
 
 	return installGolangMigrate(dbType)
@@ -464,40 +567,31 @@ func installGolangMigrate(dbType string) error {
 
 	// Check if Go is available
 	if _, err := exec.LookPath("go"); err != nil {
-		return fmt.Errorf("Go is not installed or not available in PATH. Please install Go first")
+		return failure.Tool(fmt.Errorf("Go is not installed or not available in PATH"), "install Go from https://go.dev/dl/ and make sure go is on your PATH")
 	}
 
-	// Determine the appropriate tags for the database type
-	var tags string
-	switch dbType {
-	case "postgresql":
-		tags = "postgres"
-	case "mysql":
-		tags = "mysql"
-	default:
-		tags = "postgres" // Default fallback
-	}
-
-	// Install golang-migrate with appropriate database tags
-	installCmd := fmt.Sprintf("go install -tags '%s' github.com/golang-migrate/migrate/v4/cmd/migrate@latest", tags)
+	// Install the pinned release with the driver tag for the database type
+	tags := utils.MigrateDriverTag(dbType)
 
-	fmt.Printf("   Running: %s\n", installCmd)
+	fmt.Printf("   Running: %s\n", utils.GolangMigrate.InstallCommand(tags))
 	fmt.Println("   📡 Downloading and compiling...")
+	fmt.Println("   🔐 Module checksums are verified against sum.golang.org")
 
-	cmd := exec.Command("go", "install", "-tags", tags, "github.com/golang-migrate/migrate/v4/cmd/migrate@latest")
-
-	// Capture both stdout and stderr for better error reporting
-	output, err := cmd.CombinedOutput()
+	binaryPath, err := installPinnedTool(utils.GolangMigrate, tags)
 	if err != nil {
-		fmt.Printf("   ❌ Installation failed with output:\n%s\n", string(output))
-		return fmt.Errorf("failed to install golang-migrate: %w", err)
+		fmt.Printf("   ❌ Installation failed: %v\n", err)
+		return failure.Tool(fmt.Errorf("failed to install golang-migrate: %w", err),
+			"install it manually with: "+utils.GolangMigrate.InstallCommand(tags))
 	}
 
+	fmt.Printf("   ✅ Verified %s %s (%s)\n", binaryPath, utils.GolangMigrate.Version, utils.GolangMigrate.Sum)
+
 	// Verify installation
 	if !isGolangMigrateInstalled() {
 		fmt.Println("   ⚠️  Installation completed but tool is not available in PATH")
 		fmt.Println("   💡 Try running the command in a new terminal or check your GOPATH/GOBIN settings")
-		return fmt.Errorf("golang-migrate installation completed but tool is not available in PATH")
+		return failure.Tool(fmt.Errorf("golang-migrate installation completed but tool is not available in PATH"),
+			"add $(go env GOPATH)/bin, or GOBIN if set, to your PATH")
 	}
 
 	fmt.Println("✅ golang-migrate installed successfully!")
@@ -569,49 +663,18 @@ func checkDependenciesInstalled(projectPath string) bool {
 
 // getMigrationScript returns the appropriate migration script path for the current platform
 func getMigrationScript(projectPath string) (string, error) {
-	var scriptName string
-	if runtime.GOOS == "windows" {
-		scriptName = "migrate.bat"
-	} else {
-		scriptName = "migrate.sh"
-	}
-
-	scriptPath := filepath.Join(projectPath, "scripts", scriptName)
-	if _, err := os.Stat(scriptPath); os.IsNotExist(err) {
-		return "", fmt.Errorf("migration script not found: %s", scriptPath)
-	}
-
-	return scriptPath, nil
+	return currentPlatform().findScript(projectPath, "migrate")
 }
 
 // getChangeDetectionScript returns the appropriate change detection script path for the current platform
 func getChangeDetectionScript(projectPath string) (string, error) {
-	var scriptName string
-	if runtime.GOOS == "windows" {
-		scriptName = "detect-changes.bat"
-	} else {
-		scriptName = "detect-changes.sh"
-	}
-
-	scriptPath := filepath.Join(projectPath, "scripts", scriptName)
-	if _, err := os.Stat(scriptPath); os.IsNotExist(err) {
-		return "", fmt.Errorf("change detection script not found: %s", scriptPath)
-	}
-
-	return scriptPath, nil
+	return currentPlatform().findScript(projectPath, "detect-changes")
 }
 
 // executeScript runs a script with the appropriate command for the platform
 func executeScript(scriptPath string, args ...string) *exec.Cmd {
-	if runtime.GOOS == "windows" {
-		// For Windows batch files
-		cmdArgs := append([]string{"/c", scriptPath}, args...)
-		return exec.Command("cmd", cmdArgs...)
-	} else {
-		// For Unix shell scripts
-		cmdArgs := append([]string{scriptPath}, args...)
-		return exec.Command("bash", cmdArgs...)
-	}
+	name, cmdArgs := currentPlatform().scriptCommand(scriptPath, args...)
+	return exec.Command(name, cmdArgs...)
 }
 
 // testHealthEndpoint tests the API health endpoint using HTTP client (cross-platform)
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/AlecAivazis/survey/v2"
+	"github.com/buildwithhp/gophex/internal/registry"
 	"github.com/buildwithhp/gophex/internal/utils"
 )
 
@@ -37,7 +38,7 @@ func browseForProject() error {
 		Help:    "The directory should contain a 'gophex.md' file",
 	}
 
-	err := survey.AskOne(pathPrompt, &projectPath)
+	err := askOne(pathPrompt, &projectPath)
 	if err != nil {
 		if isUserInterrupt(err) {
 			return nil
@@ -86,7 +87,7 @@ func browseForProject() error {
 			},
 		}
 
-		err := survey.AskOne(createPrompt, &createNew)
+		err := askOne(createPrompt, &createNew)
 		if err != nil {
 			if isUserInterrupt(err) {
 				return nil
@@ -140,6 +141,9 @@ func loadProject(project *DiscoveredProject) error {
 	timeAgo := formatTimeAgo(project.LastUpdated)
 	fmt.Printf("🕒 Last updated: %s\n\n", timeAgo)
 
+	upgradeProjectMetadata(project.Path)
+	registerProject(project.Name, project.Type, project.Path)
+
 	// Create project options for post-generation workflow
 	opts := PostGenerationOptions{
 		ProjectName: project.Name,
@@ -151,6 +155,32 @@ func loadProject(project *DiscoveredProject) error {
 	return ShowPostGenerationMenu(opts)
 }
 
+// upgradeProjectMetadata migrates an older gophex.md to the current schema version
+// and folds any legacy .gophex-generated file into it
+func upgradeProjectMetadata(projectPath string) {
+	from, migrated, err := utils.MigrateMetadataFile(projectPath)
+	if err != nil {
+		fmt.Printf("⚠️  Warning: Could not upgrade project metadata: %v\n", err)
+		return
+	}
+
+	if migrated {
+		fmt.Printf("🔄 Upgraded gophex.md from schema v%d to v%d (backup saved as gophex.md.v%d.bak)\n\n",
+			from, utils.CurrentSchemaVersion, from)
+	}
+
+	if _, err := utils.ConsolidateGenerationFile(projectPath); err != nil {
+		fmt.Printf("⚠️  Warning: Could not merge .gophex-generated into gophex.md: %v\n", err)
+	}
+}
+
+// registerProject adds the project to the machine-wide registry used by "gophex dashboard"
+func registerProject(name, projectType, projectPath string) {
+	if err := registry.Register(name, projectType, projectPath); err != nil {
+		fmt.Printf("⚠️  Warning: Could not register project: %v\n", err)
+	}
+}
+
 // formatTimeAgo formats a timestamp into a human-readable "time ago" string
 func formatTimeAgo(timestamp string) string {
 	if timestamp == "" {
@@ -1,81 +1,19 @@
 package cmd
 
 import (
-	"encoding/json"
-	"fmt"
-	"os"
 	"path/filepath"
-	"time"
 
 	"github.com/buildwithhp/gophex/internal/generator"
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/utils"
 	"github.com/buildwithhp/gophex/pkg/version"
 )
 
-// ProjectMetadata represents the complete project tracking information
-type ProjectMetadata struct {
-	Gophex GophexMetadata `json:"gophex"`
-}
-
-// GophexMetadata contains all Gophex-specific tracking data
-type GophexMetadata struct {
-	Version     string           `json:"version"`
-	GeneratedAt string           `json:"generated_at"`
-	Project     ProjectInfo      `json:"project"`
-	Database    DatabaseInfo     `json:"database"`
-	Redis       RedisInfo        `json:"redis"`
-	Activities  ActivityTracker  `json:"activities"`
-	Hierarchy   ProjectHierarchy `json:"hierarchy"`
-}
-
-// ProjectInfo contains basic project information
-type ProjectInfo struct {
-	Name       string `json:"name"`
-	Type       string `json:"type"`
-	Path       string `json:"path"`
-	ModuleName string `json:"module_name"`
-}
-
-// DatabaseInfo tracks database configuration (no sensitive data)
-type DatabaseInfo struct {
-	Configured              bool   `json:"configured"`
-	Type                    string `json:"type,omitempty"`        // postgresql, mysql, mongodb
-	ConfigType              string `json:"config_type,omitempty"` // single, read-write, cluster
-	MigrationsExecuted      bool   `json:"migrations_executed"`
-	InitializationCompleted bool   `json:"initialization_completed"`
-	SupportsMigrations      bool   `json:"supports_migrations"`
-}
-
-// RedisInfo tracks Redis configuration (no sensitive data)
-type RedisInfo struct {
-	Configured bool `json:"configured"`
-	Enabled    bool `json:"enabled"`
-}
-
-// ActivityTracker tracks completion status of various activities
-type ActivityTracker struct {
-	DependenciesInstalled bool `json:"dependencies_installed"`
-	DatabaseSetup         bool `json:"database_setup"`
-	ApplicationStarted    bool `json:"application_started"`
-	TestsRun              bool `json:"tests_run"`
-	HealthCheckTested     bool `json:"health_check_tested"`
-	DocumentationViewed   bool `json:"documentation_viewed"`
-	ChangeDetectionRun    bool `json:"change_detection_run"`
-}
-
-// ProjectHierarchy represents the file structure of the generated project
-type ProjectHierarchy struct {
-	Cmd        map[string]interface{} `json:"cmd,omitempty"`
-	Internal   map[string]interface{} `json:"internal,omitempty"`
-	Migrations []string               `json:"migrations,omitempty"`
-	Scripts    []string               `json:"scripts,omitempty"`
-	Web        map[string]interface{} `json:"web,omitempty"`
-	RootFiles  []string               `json:"root_files"`
-}
-
-// ProjectTracker manages project metadata operations
+// ProjectTracker records project progress in gophex.md, the single metadata store
+// shared with the generator and the metadata package
 type ProjectTracker struct {
 	projectPath string
-	metadata    *ProjectMetadata
+	metadata    *metadata.ProjectMetadata
 }
 
 // NewProjectTracker creates a new project tracker for the given project path
@@ -87,114 +25,71 @@ func NewProjectTracker(projectPath string) *ProjectTracker {
 
 // LoadMetadata loads existing project metadata from gophex.md
 func (pt *ProjectTracker) LoadMetadata() error {
-	metadataPath := filepath.Join(pt.projectPath, "gophex.md")
-
-	data, err := os.ReadFile(metadataPath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			// File doesn't exist, create default metadata
-			pt.metadata = pt.createDefaultMetadata()
-			return nil
-		}
-		return fmt.Errorf("failed to read gophex.md: %w", err)
+	if !utils.HasGophexMetadata(pt.projectPath) {
+		pt.metadata = pt.createDefaultMetadata()
+		return nil
 	}
 
-	pt.metadata = &ProjectMetadata{}
-	if err := json.Unmarshal(data, pt.metadata); err != nil {
-		return fmt.Errorf("failed to parse gophex.md: %w", err)
+	projectMetadata, err := metadata.LoadMetadata(pt.projectPath)
+	if err != nil {
+		return err
 	}
 
+	pt.metadata = projectMetadata
 	return nil
 }
 
-// SaveMetadata saves the current metadata to gophex.md
+// SaveMetadata saves the current metadata to gophex.md, preserving fields written by other tools
 func (pt *ProjectTracker) SaveMetadata() error {
-	metadataPath := filepath.Join(pt.projectPath, "gophex.md")
+	return metadata.SaveMetadata(pt.projectPath, pt.GetMetadata())
+}
 
-	data, err := json.MarshalIndent(pt.metadata, "", "  ")
-	if err != nil {
-		return fmt.Errorf("failed to marshal metadata: %w", err)
-	}
+// CreateInitialMetadata makes sure a freshly generated project has gophex.md.
+// The generator normally writes it; the file is only scanned from disk when it is missing.
+func (pt *ProjectTracker) CreateInitialMetadata(projectType, projectName, projectPath string, dbConfig *generator.DatabaseConfig, redisConfig *generator.RedisConfig) error {
+	pt.projectPath = projectPath
 
-	if err := os.WriteFile(metadataPath, data, 0644); err != nil {
-		return fmt.Errorf("failed to write gophex.md: %w", err)
+	if utils.HasGophexMetadata(projectPath) {
+		return pt.LoadMetadata()
 	}
 
-	return nil
-}
+	mg := metadata.NewMetadataGenerator(projectPath, projectType)
+	projectMetadata, err := mg.GenerateMetadata(projectName, dbConfig, redisConfig, version.GetVersion())
+	if err != nil {
+		return err
+	}
 
-// CreateInitialMetadata creates and saves initial project metadata
-func (pt *ProjectTracker) CreateInitialMetadata(projectType, projectName, projectPath string, dbConfig *generator.DatabaseConfig, redisConfig *generator.RedisConfig) error {
-	pt.metadata = &ProjectMetadata{
-		Gophex: GophexMetadata{
-			Version:     version.GetVersion(),
-			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
-			Project: ProjectInfo{
-				Name:       projectName,
-				Type:       projectType,
-				Path:       projectPath,
-				ModuleName: generateModuleName(projectName),
-			},
-			Database:   pt.createDatabaseInfo(dbConfig),
-			Redis:      pt.createRedisInfo(redisConfig),
-			Activities: ActivityTracker{}, // All activities start as false
-			Hierarchy:  pt.generateHierarchy(projectType, dbConfig),
-		},
+	if err := mg.WriteMetadataFile(projectMetadata); err != nil {
+		return err
 	}
 
-	return pt.SaveMetadata()
+	pt.metadata = projectMetadata
+	return nil
 }
 
 // GetMetadata returns the current metadata
-func (pt *ProjectTracker) GetMetadata() *ProjectMetadata {
+func (pt *ProjectTracker) GetMetadata() *metadata.ProjectMetadata {
 	if pt.metadata == nil {
 		pt.metadata = pt.createDefaultMetadata()
 	}
 	return pt.metadata
 }
 
-// UpdateActivity updates the status of a specific activity
+// UpdateActivity updates the status of a specific activity.
+// Legacy activity names such as "database_setup" are mapped to their current names.
 func (pt *ProjectTracker) UpdateActivity(activity string, completed bool) error {
-	if pt.metadata == nil {
-		if err := pt.LoadMetadata(); err != nil {
-			return err
-		}
-	}
-
-	switch activity {
-	case "dependencies_installed":
-		pt.metadata.Gophex.Activities.DependenciesInstalled = completed
-	case "database_setup":
-		pt.metadata.Gophex.Activities.DatabaseSetup = completed
-	case "application_started":
-		pt.metadata.Gophex.Activities.ApplicationStarted = completed
-	case "tests_run":
-		pt.metadata.Gophex.Activities.TestsRun = completed
-	case "health_check_tested":
-		pt.metadata.Gophex.Activities.HealthCheckTested = completed
-	case "documentation_viewed":
-		pt.metadata.Gophex.Activities.DocumentationViewed = completed
-	case "change_detection_run":
-		pt.metadata.Gophex.Activities.ChangeDetectionRun = completed
-	default:
-		return fmt.Errorf("unknown activity: %s", activity)
+	if err := metadata.UpdateActivity(pt.projectPath, utils.CanonicalActivityName(activity), completed); err != nil {
+		return err
 	}
-
-	return pt.SaveMetadata()
+	return pt.LoadMetadata()
 }
 
 // UpdateDatabaseStatus updates database-related status
-func (pt *ProjectTracker) UpdateDatabaseStatus(migrationsExecuted, initializationCompleted bool) error {
-	if pt.metadata == nil {
-		if err := pt.LoadMetadata(); err != nil {
-			return err
-		}
+func (pt *ProjectTracker) UpdateDatabaseStatus(migrationsExecuted, schemaInitialized bool) error {
+	if err := metadata.UpdateDatabaseStatus(pt.projectPath, migrationsExecuted, schemaInitialized); err != nil {
+		return err
 	}
-
-	pt.metadata.Gophex.Database.MigrationsExecuted = migrationsExecuted
-	pt.metadata.Gophex.Database.InitializationCompleted = initializationCompleted
-
-	return pt.SaveMetadata()
+	return pt.LoadMetadata()
 }
 
 // IsActivityCompleted checks if a specific activity has been completed
@@ -203,24 +98,7 @@ func (pt *ProjectTracker) IsActivityCompleted(activity string) bool {
 		return false
 	}
 
-	switch activity {
-	case "dependencies_installed":
-		return pt.metadata.Gophex.Activities.DependenciesInstalled
-	case "database_setup":
-		return pt.metadata.Gophex.Activities.DatabaseSetup
-	case "application_started":
-		return pt.metadata.Gophex.Activities.ApplicationStarted
-	case "tests_run":
-		return pt.metadata.Gophex.Activities.TestsRun
-	case "health_check_tested":
-		return pt.metadata.Gophex.Activities.HealthCheckTested
-	case "documentation_viewed":
-		return pt.metadata.Gophex.Activities.DocumentationViewed
-	case "change_detection_run":
-		return pt.metadata.Gophex.Activities.ChangeDetectionRun
-	default:
-		return false
-	}
+	return pt.metadata.Activities[utils.CanonicalActivityName(activity)].Completed
 }
 
 // GetActivityPrefix returns "Re-" if activity is completed, empty string otherwise
@@ -232,160 +110,15 @@ func (pt *ProjectTracker) GetActivityPrefix(activity string) string {
 }
 
 // createDefaultMetadata creates default metadata when none exists
-func (pt *ProjectTracker) createDefaultMetadata() *ProjectMetadata {
-	return &ProjectMetadata{
-		Gophex: GophexMetadata{
-			Version:     version.GetVersion(),
-			GeneratedAt: time.Now().UTC().Format(time.RFC3339),
-			Project:     ProjectInfo{},
-			Database:    DatabaseInfo{},
-			Redis:       RedisInfo{},
-			Activities:  ActivityTracker{},
-			Hierarchy:   ProjectHierarchy{RootFiles: []string{}},
+func (pt *ProjectTracker) createDefaultMetadata() *metadata.ProjectMetadata {
+	return &metadata.ProjectMetadata{
+		SchemaVersion: utils.CurrentSchemaVersion,
+		Project: metadata.ProjectInfo{
+			Name:          filepath.Base(pt.projectPath),
+			GophexVersion: version.GetVersion(),
 		},
+		Hierarchy:  make(map[string]interface{}),
+		Activities: make(map[string]metadata.ActivityInfo),
+		Features:   make(map[string]bool),
 	}
 }
-
-// createDatabaseInfo creates database info from config (no sensitive data)
-func (pt *ProjectTracker) createDatabaseInfo(dbConfig *generator.DatabaseConfig) DatabaseInfo {
-	if dbConfig == nil {
-		return DatabaseInfo{
-			Configured: false,
-		}
-	}
-
-	supportsMigrations := dbConfig.Type == "postgresql" || dbConfig.Type == "mysql"
-
-	return DatabaseInfo{
-		Configured:         true,
-		Type:               dbConfig.Type,
-		ConfigType:         dbConfig.ConfigType,
-		SupportsMigrations: supportsMigrations,
-	}
-}
-
-// createRedisInfo creates Redis info from config (no sensitive data)
-func (pt *ProjectTracker) createRedisInfo(redisConfig *generator.RedisConfig) RedisInfo {
-	if redisConfig == nil {
-		return RedisInfo{
-			Configured: false,
-		}
-	}
-
-	return RedisInfo{
-		Configured: true,
-		Enabled:    redisConfig.Enabled,
-	}
-}
-
-// generateHierarchy creates the project hierarchy based on project type and database
-func (pt *ProjectTracker) generateHierarchy(projectType string, dbConfig *generator.DatabaseConfig) ProjectHierarchy {
-	hierarchy := ProjectHierarchy{
-		RootFiles: []string{".env", ".env.example", ".gophex-generated", "go.mod", "README.md"},
-	}
-
-	switch projectType {
-	case "api":
-		hierarchy.Cmd = map[string]interface{}{
-			"api/": []string{"main.go"},
-		}
-
-		hierarchy.Internal = map[string]interface{}{
-			"api/": map[string]interface{}{
-				"handlers/":   []string{"auth.go", "health.go", "posts.go", "users.go"},
-				"middleware/": []string{"auth.go", "cors.go", "logging.go", "ratelimit.go"},
-				"responses/":  []string{"error.go", "success.go"},
-				"routes/":     []string{"routes.go"},
-			},
-			"config/":   []string{"config.go"},
-			"database/": []string{"config.go", "database.go", "factory.go"},
-			"domain/": map[string]interface{}{
-				"post/": []string{"model.go", "repository.go", "service.go"},
-				"user/": []string{"model.go", "repository.go", "service.go"},
-			},
-			"infrastructure/": map[string]interface{}{
-				"auth/":     []string{"jwt.go", "password.go"},
-				"database/": pt.getDatabaseInfrastructure(dbConfig),
-			},
-			"pkg/": map[string]interface{}{
-				"errors/":    []string{"errors.go"},
-				"logger/":    []string{"logger.go"},
-				"validator/": []string{"validator.go"},
-			},
-		}
-
-		if dbConfig != nil && (dbConfig.Type == "postgresql" || dbConfig.Type == "mysql") {
-			hierarchy.Migrations = []string{
-				"000001_create_users_table.up.sql",
-				"000001_create_users_table.down.sql",
-				"000002_create_posts_table.up.sql",
-				"000002_create_posts_table.down.sql",
-				"README.md",
-			}
-		} else if dbConfig != nil && dbConfig.Type == "mongodb" {
-			hierarchy.Migrations = []string{
-				"mongodb_init.js",
-				"README.md",
-			}
-		}
-
-		hierarchy.Scripts = []string{"detect-changes.sh", "migrate.sh"}
-
-	case "webapp":
-		hierarchy.Cmd = map[string]interface{}{
-			"webapp/": []string{"main.go"},
-		}
-		hierarchy.Web = map[string]interface{}{
-			"static/": map[string]interface{}{
-				"css/": []string{"style.css"},
-			},
-			"templates/": []string{"index.html"},
-		}
-
-	case "microservice":
-		hierarchy.Cmd = map[string]interface{}{
-			"server/": []string{"main.go"},
-		}
-		hierarchy.Internal = map[string]interface{}{
-			"handlers/": []string{"handlers.go"},
-		}
-
-	case "cli":
-		hierarchy.Cmd = map[string]interface{}{
-			"main.go": nil,
-		}
-		hierarchy.Internal = map[string]interface{}{
-			"cmd/": []string{"root.go"},
-		}
-	}
-
-	return hierarchy
-}
-
-// getDatabaseInfrastructure returns database-specific infrastructure files
-func (pt *ProjectTracker) getDatabaseInfrastructure(dbConfig *generator.DatabaseConfig) map[string]interface{} {
-	infrastructure := make(map[string]interface{})
-
-	if dbConfig == nil {
-		return infrastructure
-	}
-
-	switch dbConfig.Type {
-	case "postgresql":
-		infrastructure["postgres/"] = []string{"connection.go", "post_repo.go", "user_repo.go"}
-	case "mysql":
-		infrastructure["mysql/"] = []string{"connection.go", "post_repo.go", "user_repo.go"}
-	case "mongodb":
-		infrastructure["mongodb/"] = []string{"connection.go", "post_repo.go", "user_repo.go"}
-	}
-
-	infrastructure["redis/"] = []string{"client.go"}
-
-	return infrastructure
-}
-
-// generateModuleName generates a Go module name from project name
-func generateModuleName(projectName string) string {
-	// Simple module name generation - can be enhanced later
-	return fmt.Sprintf("github.com/user/%s", projectName)
-}
@@ -38,14 +38,19 @@ func isUserInterrupt(err error) bool {
 		strings.Contains(errStr, "canceled")
 }
 
-// clearScreen clears the terminal screen for a cleaner user experience
+// clearScreen clears the terminal screen for a cleaner user experience.
+// Accessible mode keeps the screen, so screen readers don't lose their place, and
+// --defaults keeps it so recordings and CI logs show every prompt.
 func clearScreen() {
+	if accessibleMode || autoAnswer != nil {
+		return
+	}
 	fmt.Print("\033[H\033[2J")
 }
 
-// askWithInterruptHandling wraps survey.AskOne with graceful interrupt handling
+// askWithInterruptHandling wraps askOne with graceful interrupt handling
 func askWithInterruptHandling(prompt survey.Prompt, response interface{}, opts ...survey.AskOpt) error {
-	err := survey.AskOne(prompt, response, opts...)
+	err := askOne(prompt, response, opts...)
 	if err != nil && isUserInterrupt(err) {
 		fmt.Println("\nOperation cancelled. Goodbye! 👋")
 		os.Exit(0)
@@ -106,6 +111,9 @@ func Execute() error {
 			"Show help",
 			"Quit",
 		}
+		if accessibleMode {
+			options = removeOption(options, "Print image")
+		}
 	}
 
 	for {
@@ -115,7 +123,7 @@ func Execute() error {
 			Options: options,
 		}
 
-		err = survey.AskOne(prompt, &action)
+		err = askOne(prompt, &action)
 		if err != nil {
 			// Handle user interruption (Ctrl+C) gracefully
 			if isUserInterrupt(err) {
@@ -199,6 +207,9 @@ func loadCurrentProject(projectPath string, metadata *utils.ProjectMetadata) err
 	fmt.Printf("📂 Loading current project: %s (%s)\n", metadata.Project.Name, metadata.Project.Type)
 	fmt.Printf("📍 Location: %s\n", projectPath)
 
+	upgradeProjectMetadata(projectPath)
+	registerProject(metadata.Project.Name, metadata.Project.Type, projectPath)
+
 	// Create project options for post-generation workflow
 	opts := PostGenerationOptions{
 		ProjectName: metadata.Project.Name,
@@ -210,11 +221,21 @@ func loadCurrentProject(projectPath string, metadata *utils.ProjectMetadata) err
 	return ShowPostGenerationMenu(opts)
 }
 
+// removeOption returns options without option
+func removeOption(options []string, option string) []string {
+	kept := make([]string, 0, len(options))
+	for _, o := range options {
+		if o != option {
+			kept = append(kept, o)
+		}
+	}
+	return kept
+}
+
 func printHelp() {
 	fmt.Println("Gophex - Go Project Generator")
 	fmt.Println()
-	fmt.Println("Usage:")
-	fmt.Println("  gophex                 Start interactive mode")
+	printCommandUsage(os.Stdout)
 	fmt.Println()
 	fmt.Println("Features:")
 	fmt.Println("  - Generate new projects with clean architecture")
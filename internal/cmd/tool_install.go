@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"debug/buildinfo"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// checksumEnforcement overrides settings that would let go install skip the checksum
+// database. These also take precedence over values saved with 'go env -w'.
+var checksumEnforcement = []string{
+	"GOSUMDB=sum.golang.org",
+	"GONOSUMDB=",
+	"GOPRIVATE=",
+	"GOINSECURE=",
+	"GOFLAGS=",
+}
+
+// verifiedInstallEnv returns environ with checksum verification forced on for go install
+func verifiedInstallEnv(environ []string) []string {
+	overridden := make(map[string]bool, len(checksumEnforcement))
+	for _, setting := range checksumEnforcement {
+		name, _, _ := strings.Cut(setting, "=")
+		overridden[name] = true
+	}
+
+	env := make([]string, 0, len(environ)+len(checksumEnforcement))
+	for _, entry := range environ {
+		name, _, _ := strings.Cut(entry, "=")
+		if !overridden[name] {
+			env = append(env, entry)
+		}
+	}
+	return append(env, checksumEnforcement...)
+}
+
+// installPinnedTool installs a pinned tool with go install, verifying module checksums
+// against sum.golang.org during the download and the built binary against the pin afterwards
+func installPinnedTool(tool utils.PinnedTool, tags string) (string, error) {
+	args := []string{"install"}
+	if tags != "" {
+		args = append(args, "-tags", tags)
+	}
+	args = append(args, tool.Target())
+
+	cmd := exec.Command("go", args...)
+	cmd.Env = verifiedInstallEnv(os.Environ())
+
+	// Capture both stdout and stderr for better error reporting
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return "", fmt.Errorf("go install failed: %w\n%s", err, strings.TrimSpace(string(output)))
+	}
+
+	binDir, err := goInstallDir()
+	if err != nil {
+		return "", err
+	}
+
+	binaryPath := filepath.Join(binDir, filepath.Base(tool.Package)+goExe())
+	if err := verifyToolBinary(binaryPath, tool); err != nil {
+		// Never leave an unverified binary where it will be found on the PATH
+		os.Remove(binaryPath)
+		return "", err
+	}
+
+	return binaryPath, nil
+}
+
+// verifyToolBinary checks that a binary was built from exactly the pinned module version and checksum
+func verifyToolBinary(binaryPath string, tool utils.PinnedTool) error {
+	info, err := buildinfo.ReadFile(binaryPath)
+	if err != nil {
+		return fmt.Errorf("failed to read build info from %s: %w", binaryPath, err)
+	}
+
+	if info.Main.Path != tool.Module {
+		return fmt.Errorf("checksum verification failed: %s was built from %s, expected %s", binaryPath, info.Main.Path, tool.Module)
+	}
+	if info.Main.Version != tool.Version {
+		return fmt.Errorf("checksum verification failed: %s is version %s, expected %s", binaryPath, info.Main.Version, tool.Version)
+	}
+	if info.Main.Sum != tool.Sum {
+		return fmt.Errorf("checksum verification failed: %s has module hash %q, expected %q", binaryPath, info.Main.Sum, tool.Sum)
+	}
+
+	return nil
+}
+
+// goInstallDir returns the directory go install writes binaries to
+func goInstallDir() (string, error) {
+	output, err := exec.Command("go", "env", "GOBIN", "GOPATH").Output()
+	if err != nil {
+		return "", fmt.Errorf("failed to read go environment: %w", err)
+	}
+
+	lines := strings.Split(strings.TrimSpace(string(output)), "\n")
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) != "" {
+		return strings.TrimSpace(lines[0]), nil
+	}
+	if len(lines) > 1 {
+		// GOPATH may list several directories; go install uses the first
+		gopath := filepath.SplitList(strings.TrimSpace(lines[1]))
+		if len(gopath) > 0 && gopath[0] != "" {
+			return filepath.Join(gopath[0], "bin"), nil
+		}
+	}
+
+	return "", fmt.Errorf("could not determine the go install directory")
+}
+
+// goExe returns the executable suffix for the current platform
+func goExe() string {
+	if currentPlatform().isWindows() {
+		return ".exe"
+	}
+	return ""
+}
@@ -0,0 +1,73 @@
+package cmd
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+func TestVerifiedInstallEnv(t *testing.T) {
+	environ := []string{
+		"HOME=/home/dev",
+		"GOSUMDB=off",
+		"GONOSUMDB=github.com/golang-migrate",
+		"GOPRIVATE=*",
+		"GOINSECURE=github.com",
+		"GOFLAGS=-insecure",
+		"GOPROXY=https://proxy.golang.org",
+	}
+
+	env := verifiedInstallEnv(environ)
+
+	values := make(map[string][]string)
+	for _, entry := range env {
+		name, value, _ := strings.Cut(entry, "=")
+		values[name] = append(values[name], value)
+	}
+
+	expected := map[string]string{
+		"HOME":       "/home/dev",
+		"GOPROXY":    "https://proxy.golang.org",
+		"GOSUMDB":    "sum.golang.org",
+		"GONOSUMDB":  "",
+		"GOPRIVATE":  "",
+		"GOINSECURE": "",
+		"GOFLAGS":    "",
+	}
+	for name, want := range expected {
+		got := values[name]
+		if len(got) != 1 || got[0] != want {
+			t.Errorf("Expected %s=%q exactly once, got %q", name, want, got)
+		}
+	}
+}
+
+func TestVerifyToolBinary(t *testing.T) {
+	t.Run("binary from another module", func(t *testing.T) {
+		// The test binary is a Go executable with build info, just not golang-migrate
+		self, err := os.Executable()
+		if err != nil {
+			t.Fatalf("Failed to locate test binary: %v", err)
+		}
+
+		err = verifyToolBinary(self, utils.GolangMigrate)
+		if err == nil || !strings.Contains(err.Error(), "checksum verification failed") {
+			t.Errorf("Expected checksum verification error, got %v", err)
+		}
+	})
+
+	t.Run("not a Go binary", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "migrate")
+		if err := os.WriteFile(path, []byte("#!/bin/sh\necho migrate\n"), 0755); err != nil {
+			t.Fatalf("Failed to write fake binary: %v", err)
+		}
+
+		err := verifyToolBinary(path, utils.GolangMigrate)
+		if err == nil || !strings.Contains(err.Error(), "failed to read build info") {
+			t.Errorf("Expected build info error, got %v", err)
+		}
+	})
+}
@@ -0,0 +1,192 @@
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	goversion "go/version"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/buildwithhp/gophex/internal/deps"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// upgradeUsage describes the upgrade subcommand
+const upgradeUsage = "gophex upgrade --deps [--dry-run] [--path DIR]"
+
+// moduleRequirement is a require entry as reported by 'go mod edit -json'
+type moduleRequirement struct {
+	Path     string
+	Version  string
+	Indirect bool
+}
+
+// dependencyUpgrade compares one go.mod requirement with its catalog pin
+type dependencyUpgrade struct {
+	Module  string
+	Current string
+	Catalog string
+	Upgrade bool
+}
+
+// runUpgrade implements "gophex upgrade --deps [--dry-run] [--path DIR]"
+func runUpgrade(args []string, out io.Writer) error {
+	fs := flag.NewFlagSet("upgrade", flag.ContinueOnError)
+	upgradeDeps := fs.Bool("deps", false, "refresh go.mod requirements to the Gophex dependency catalog")
+	dryRun := fs.Bool("dry-run", false, "show the changes without editing go.mod")
+	projectPath := fs.String("path", ".", "project directory containing go.mod")
+
+	positional, err := parseCommandFlags(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if len(positional) != 0 {
+		return usageError(upgradeUsage)
+	}
+
+	if !*upgradeDeps {
+		return failure.User(fmt.Errorf("nothing to upgrade: pass --deps (usage: %s)", upgradeUsage), "")
+	}
+
+	if _, err := os.Stat(filepath.Join(*projectPath, "go.mod")); err != nil {
+		return failure.Environment(fmt.Errorf("go.mod not found in %s", *projectPath), utils.ProjectDirHint)
+	}
+
+	requirements, err := readRequirements(*projectPath)
+	if err != nil {
+		return err
+	}
+
+	plan := planDependencyUpgrades(requirements)
+	if len(plan) == 0 {
+		fmt.Fprintln(out, "No catalog dependencies found in go.mod")
+		return nil
+	}
+
+	if err := writeUpgradePlan(out, plan); err != nil {
+		return err
+	}
+
+	var targets []string
+	for _, upgrade := range plan {
+		if upgrade.Upgrade {
+			targets = append(targets, "-require="+upgrade.Module+"@"+upgrade.Catalog)
+		}
+	}
+
+	if len(targets) == 0 {
+		fmt.Fprintf(out, "\n✅ Dependencies already match catalog %s\n", deps.CatalogVersion)
+		return nil
+	}
+
+	if *dryRun {
+		fmt.Fprintln(out)
+		writeDiff(out, upgradeDiff(plan))
+		fmt.Fprintf(out, "\n%d module(s) would be upgraded to catalog %s (dry run, go.mod not changed)\n", len(targets), deps.CatalogVersion)
+		return nil
+	}
+
+	selectedGo := goDirective(filepath.Join(*projectPath, "go.mod"))
+
+	if err := runGoCommand(*projectPath, append([]string{"mod", "edit"}, targets...)...); err != nil {
+		return fmt.Errorf("failed to update go.mod: %w", err)
+	}
+	if err := runGoCommand(*projectPath, "mod", "tidy"); err != nil {
+		return failure.Tool(fmt.Errorf("go mod tidy failed: %w", err), "fix the reported module errors, then run the upgrade again")
+	}
+
+	if resolvedGo := goDirective(filepath.Join(*projectPath, "go.mod")); selectedGo != "" && goversion.Compare("go"+resolvedGo, "go"+selectedGo) > 0 {
+		fmt.Fprintf(out, "\n⚠️  go.mod now requires Go %s instead of %s\n", resolvedGo, selectedGo)
+	}
+
+	fmt.Fprintf(out, "\n✅ Upgraded %d module(s) to catalog %s\n", len(targets), deps.CatalogVersion)
+	return nil
+}
+
+// readRequirements lists the require entries of the go.mod in projectPath
+func readRequirements(projectPath string) ([]moduleRequirement, error) {
+	cmd := exec.Command("go", "mod", "edit", "-json")
+	cmd.Dir = projectPath
+
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, failure.Tool(fmt.Errorf("failed to read go.mod: %w", err), "check that Go is installed and go.mod is valid")
+	}
+
+	var goMod struct {
+		Require []moduleRequirement
+	}
+	if err := json.Unmarshal(output, &goMod); err != nil {
+		return nil, fmt.Errorf("failed to parse go.mod: %w", err)
+	}
+
+	return goMod.Require, nil
+}
+
+// planDependencyUpgrades compares requirements with the catalog; versions newer than the
+// catalog are kept, so upgrading never downgrades a module the user bumped themselves
+func planDependencyUpgrades(requirements []moduleRequirement) []dependencyUpgrade {
+	var plan []dependencyUpgrade
+	for _, requirement := range requirements {
+		pinned, ok := deps.Lookup(requirement.Path)
+		if !ok {
+			continue
+		}
+
+		plan = append(plan, dependencyUpgrade{
+			Module:  requirement.Path,
+			Current: requirement.Version,
+			Catalog: pinned.Version,
+			Upgrade: deps.CompareVersions(requirement.Version, pinned.Version) < 0,
+		})
+	}
+	return plan
+}
+
+// writeUpgradePlan renders the planned dependency changes as an aligned table
+func writeUpgradePlan(out io.Writer, plan []dependencyUpgrade) error {
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(w, "MODULE\tCURRENT\tCATALOG\tACTION")
+	for _, upgrade := range plan {
+		action := "up to date"
+		switch {
+		case upgrade.Upgrade:
+			action = "upgrade"
+		case upgrade.Current != upgrade.Catalog:
+			action = "keep (newer than catalog)"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", upgrade.Module, upgrade.Current, upgrade.Catalog, action)
+	}
+	return w.Flush()
+}
+
+// upgradeDiff shows the go.mod requirements the plan would change. go mod tidy may adjust
+// other requirements afterwards.
+func upgradeDiff(plan []dependencyUpgrade) string {
+	var diff strings.Builder
+	diff.WriteString("--- a/go.mod\n+++ b/go.mod\n")
+	for _, upgrade := range plan {
+		if upgrade.Upgrade {
+			fmt.Fprintf(&diff, "-\t%s %s\n+\t%s %s\n", upgrade.Module, upgrade.Current, upgrade.Module, upgrade.Catalog)
+		}
+	}
+	return diff.String()
+}
+
+// runGoCommand runs a go subcommand in dir, including its output in any error
+func runGoCommand(dir string, args ...string) error {
+	cmd := exec.Command("go", args...)
+	cmd.Dir = dir
+
+	if output, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("%w\n%s", err, strings.TrimSpace(string(output)))
+	}
+	return nil
+}
@@ -39,7 +39,7 @@ func RunDevelopmentWorkflow(projectPath, projectType string) error {
 		},
 	}
 
-	if err := survey.AskOne(confirmPrompt, &choice); err != nil {
+	if err := askOne(confirmPrompt, &choice); err != nil {
 		if isUserInterrupt(err) {
 			return GetProcessManager().HandleGracefulShutdown()
 		}
@@ -104,7 +104,7 @@ func RunDevelopmentWorkflow(projectPath, projectType string) error {
 		},
 	}
 
-	if err := survey.AskOne(startPrompt, &startApp); err != nil {
+	if err := askOne(startPrompt, &startApp); err != nil {
 		return err
 	}
 
@@ -0,0 +1,108 @@
+// Package deps is the curated catalog of third-party module versions that
+// generated projects pin in go.mod and "gophex upgrade --deps" refreshes to.
+package deps
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// CatalogVersion identifies this revision of the catalog; bump it whenever a pin changes
+const CatalogVersion = "2025.2"
+
+// Dependency is a module pinned to a version known to build with the templates
+type Dependency struct {
+	Module    string
+	Version   string
+	GoVersion string // go directive of the pinned version's go.mod
+	Purpose   string
+}
+
+// catalog pins every third-party module the templates import. Each version must
+// build with the oldest Go release Gophex generates for (see generator.MinimumGoVersion).
+var catalog = []Dependency{
+	{Module: "github.com/gin-gonic/gin", Version: "v1.10.1", GoVersion: "1.20", Purpose: "Gin web framework"},
+	{Module: "github.com/labstack/echo/v4", Version: "v4.13.3", GoVersion: "1.20", Purpose: "Echo web framework"},
+	{Module: "github.com/gorilla/mux", Version: "v1.8.1", GoVersion: "1.20", Purpose: "Gorilla router"},
+	{Module: "github.com/lib/pq", Version: "v1.10.9", GoVersion: "1.13", Purpose: "PostgreSQL driver"},
+	{Module: "github.com/go-sql-driver/mysql", Version: "v1.9.3", GoVersion: "1.21.0", Purpose: "MySQL driver"},
+	{Module: "go.mongodb.org/mongo-driver", Version: "v1.17.4", GoVersion: "1.18", Purpose: "MongoDB driver"},
+	{Module: "github.com/go-redis/redis/v8", Version: "v8.11.5", GoVersion: "1.17", Purpose: "Redis client"},
+	{Module: "github.com/golang-jwt/jwt/v5", Version: "v5.3.1", GoVersion: "1.21", Purpose: "JWT authentication"},
+	{Module: "golang.org/x/crypto", Version: "v0.33.0", GoVersion: "1.20", Purpose: "bcrypt password hashing"},
+	{Module: "gopkg.in/yaml.v3", Version: "v3.0.1", Purpose: "YAML configuration"},
+	{Module: "github.com/spf13/cobra", Version: "v1.10.2", GoVersion: "1.15", Purpose: "CLI framework"},
+	{Module: "go.opentelemetry.io/otel", Version: "v1.28.0", GoVersion: "1.21", Purpose: "OpenTelemetry API and propagation"},
+	{Module: "go.opentelemetry.io/otel/sdk", Version: "v1.28.0", GoVersion: "1.21", Purpose: "OpenTelemetry tracer provider"},
+	{Module: "go.opentelemetry.io/otel/trace", Version: "v1.28.0", GoVersion: "1.21", Purpose: "OpenTelemetry span context"},
+	{Module: "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp", Version: "v1.28.0", GoVersion: "1.21", Purpose: "OTLP/HTTP trace exporter"},
+}
+
+// All returns every pinned dependency in catalog order
+func All() []Dependency {
+	return append([]Dependency{}, catalog...)
+}
+
+// Lookup returns the pinned dependency for a module path
+func Lookup(module string) (Dependency, bool) {
+	for _, dep := range catalog {
+		if dep.Module == module {
+			return dep, true
+		}
+	}
+	return Dependency{}, false
+}
+
+// Require returns the "module version" line for a go.mod require block
+func Require(module string) (string, error) {
+	dep, ok := Lookup(module)
+	if !ok {
+		return "", fmt.Errorf("module %s is not in the dependency catalog", module)
+	}
+	return dep.Module + " " + dep.Version, nil
+}
+
+// CompareVersions compares two module versions by semantic version precedence,
+// returning -1, 0 or +1. Build metadata is ignored, as in Go's module system.
+func CompareVersions(a, b string) int {
+	aCore, aPre := splitVersion(a)
+	bCore, bPre := splitVersion(b)
+
+	for i := 0; i < 3; i++ {
+		if aCore[i] != bCore[i] {
+			if aCore[i] < bCore[i] {
+				return -1
+			}
+			return 1
+		}
+	}
+
+	// A release sorts after any of its pre-releases (pseudo-versions included)
+	switch {
+	case aPre == bPre:
+		return 0
+	case aPre == "":
+		return 1
+	case bPre == "":
+		return -1
+	case aPre < bPre:
+		return -1
+	default:
+		return 1
+	}
+}
+
+// splitVersion parses vMAJOR.MINOR.PATCH[-prerelease][+build] into numbers and prerelease
+func splitVersion(version string) ([3]int, string) {
+	var core [3]int
+
+	version = strings.TrimPrefix(version, "v")
+	version, _, _ = strings.Cut(version, "+")
+	version, prerelease, _ := strings.Cut(version, "-")
+
+	for i, part := range strings.SplitN(version, ".", 3) {
+		core[i], _ = strconv.Atoi(part)
+	}
+	return core, prerelease
+}
@@ -0,0 +1,56 @@
+package deps
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompareVersions(t *testing.T) {
+	tests := []struct {
+		a, b     string
+		expected int
+	}{
+		{"v1.10.1", "v1.10.1", 0},
+		{"v1.9.1", "v1.10.1", -1},
+		{"v1.10.1", "v1.9.1", 1},
+		{"v2.0.0", "v1.99.99", 1},
+		{"v1.10.0-rc.1", "v1.10.0", -1},
+		{"v1.10.0", "v1.10.0-rc.1", 1},
+		{"v0.0.0-20200823014737-9f7001d12a5f", "v0.1.0", -1},
+		{"v3.0.1+incompatible", "v3.0.1", 0},
+	}
+
+	for _, tt := range tests {
+		if got := CompareVersions(tt.a, tt.b); got != tt.expected {
+			t.Errorf("Expected CompareVersions(%s, %s) = %d, got %d", tt.a, tt.b, tt.expected, got)
+		}
+	}
+}
+
+func TestRequire(t *testing.T) {
+	line, err := Require("github.com/gin-gonic/gin")
+	if err != nil {
+		t.Fatalf("Expected gin to be in the catalog: %v", err)
+	}
+	if !strings.HasPrefix(line, "github.com/gin-gonic/gin v") {
+		t.Errorf("Expected a go.mod require line, got %q", line)
+	}
+
+	if _, err := Require("github.com/example/unknown"); err == nil {
+		t.Error("Expected error for a module missing from the catalog")
+	}
+}
+
+func TestCatalogEntries(t *testing.T) {
+	seen := make(map[string]bool)
+	for _, dep := range All() {
+		if seen[dep.Module] {
+			t.Errorf("Expected %s to be pinned once", dep.Module)
+		}
+		seen[dep.Module] = true
+
+		if !strings.HasPrefix(dep.Version, "v") || strings.Contains(dep.Version, "-") {
+			t.Errorf("Expected a tagged release for %s, got %s", dep.Module, dep.Version)
+		}
+	}
+}
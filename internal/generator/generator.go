@@ -1,25 +1,187 @@
 package generator
 
 import (
+	"encoding/json"
 	"fmt"
+	goversion "go/version"
 	"os"
+	"os/exec"
+	"path"
 	"path/filepath"
 	"strings"
 	"time"
 
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
 	"github.com/buildwithhp/gophex/internal/templates"
 	"github.com/buildwithhp/gophex/internal/types"
+	"github.com/buildwithhp/gophex/internal/utils"
+	"github.com/buildwithhp/gophex/pkg/version"
 )
 
 type DatabaseConfig = types.DatabaseConfig
 type RedisConfig = types.RedisConfig
+type DockerConfig = types.DockerConfig
+type GoConfig = types.GoConfig
 
-type Generator struct{}
+// Supported container runtime images, smallest attack surface first
+var RuntimeImages = []string{"distroless", "alpine", "scratch"}
+
+// DefaultPlatforms are the architectures built by the generated buildx targets
+var DefaultPlatforms = []string{"linux/amd64", "linux/arm64"}
+
+// MinimumGoVersion is the oldest Go release the templates build with (log/slog needs 1.21)
+const MinimumGoVersion = "1.21"
+
+// DefaultGoVersion is the go directive written when no Go version is selected
+const DefaultGoVersion = "1.22"
+
+// SupportedGoVersions are the Go releases offered by the wizards, newest first
+var SupportedGoVersions = []string{"1.24", "1.23", "1.22", "1.21"}
+
+// MinimalDepsGoVersion is the oldest Go release for minimal dependency mode, which
+// routes HTTP requests with the method and wildcard patterns ServeMux gained in 1.22
+const MinimalDepsGoVersion = "1.22"
+
+type Generator struct {
+	docker      *DockerConfig
+	goCfg       *GoConfig
+	minimalDeps bool
+	tracing     bool
+	origins     map[string]string // generated file → embedded template it was rendered from
+}
 
 func New() *Generator {
 	return &Generator{}
 }
 
+// WithDockerConfig sets the container image options used by the generated Dockerfile, Makefile and CI
+func (g *Generator) WithDockerConfig(dockerConfig *DockerConfig) *Generator {
+	g.docker = dockerConfig
+	return g
+}
+
+// dockerTemplateConfig resolves the Docker options for templates, applying defaults
+func (g *Generator) dockerTemplateConfig() (templates.DockerConfig, error) {
+	config := templates.DockerConfig{
+		RuntimeImage: RuntimeImages[0],
+		Platforms:    DefaultPlatforms,
+	}
+
+	if g.docker != nil {
+		if g.docker.RuntimeImage != "" {
+			config.RuntimeImage = g.docker.RuntimeImage
+		}
+		if len(g.docker.Platforms) > 0 {
+			config.Platforms = g.docker.Platforms
+		}
+	}
+
+	for _, image := range RuntimeImages {
+		if image == config.RuntimeImage {
+			return config, nil
+		}
+	}
+	return config, failure.User(fmt.Errorf("unsupported runtime image: %s", config.RuntimeImage),
+		"choose one of: "+strings.Join(RuntimeImages, ", "))
+}
+
+// WithGoConfig sets the Go version and toolchain written to the generated go.mod
+func (g *Generator) WithGoConfig(goConfig *GoConfig) *Generator {
+	g.goCfg = goConfig
+	return g
+}
+
+// goTemplateConfig resolves the Go version for templates, applying defaults
+func (g *Generator) goTemplateConfig() (templates.GoConfig, error) {
+	config := templates.GoConfig{Version: DefaultGoVersion}
+	if g.goCfg != nil {
+		if g.goCfg.Version != "" {
+			config.Version = g.goCfg.Version
+		}
+		config.Toolchain = g.goCfg.Toolchain
+	}
+
+	if !goversion.IsValid("go"+config.Version) || goversion.Compare("go"+config.Version, "go"+MinimumGoVersion) < 0 {
+		return config, failure.User(fmt.Errorf("unsupported Go version: %s (minimum is %s)", config.Version, MinimumGoVersion), "")
+	}
+	if config.Toolchain != "" {
+		if !goversion.IsValid(config.Toolchain) {
+			return config, failure.User(fmt.Errorf("invalid toolchain: %s", config.Toolchain), "use a toolchain name such as go1.24.5")
+		}
+		if goversion.Compare(config.Toolchain, "go"+config.Version) < 0 {
+			return config, failure.User(fmt.Errorf("toolchain %s is older than go %s", config.Toolchain, config.Version), "")
+		}
+	}
+	return config, nil
+}
+
+// WithMinimalDeps swaps third-party packages for standard library equivalents wherever
+// possible: net/http routing, log/slog logging, database/sql databases, encoding/json
+// config and HS256 tokens, and the flag package for CLIs
+func (g *Generator) WithMinimalDeps(enabled bool) *Generator {
+	g.minimalDeps = enabled
+	return g
+}
+
+// WithTracing adds OpenTelemetry tracing to API projects: a middleware starts a span for
+// every request and returns its IDs in response headers, and the logger adds them to every
+// record logged with the request context
+func (g *Generator) WithTracing(enabled bool) *Generator {
+	g.tracing = enabled
+	return g
+}
+
+// validateMinimalDeps rejects options that cannot be met without a third-party package
+func (g *Generator) validateMinimalDeps(projectType, framework string, dbConfig *DatabaseConfig, redisConfig *RedisConfig) error {
+	if !g.minimalDeps {
+		return nil
+	}
+
+	goConfig, err := g.goTemplateConfig()
+	if err != nil {
+		return err
+	}
+	if projectType != "cli" && !goConfig.AtLeast(MinimalDepsGoVersion) {
+		return failure.User(fmt.Errorf("minimal dependency mode requires Go %s or newer for net/http routing (selected %s)", MinimalDepsGoVersion, goConfig.Version), "")
+	}
+	if framework != "" {
+		return failure.User(fmt.Errorf("minimal dependency mode uses net/http routing and cannot be combined with the %s framework", framework), "")
+	}
+	if dbConfig != nil && dbConfig.Type == "mongodb" {
+		return failure.User(fmt.Errorf("minimal dependency mode supports database/sql databases only (postgresql, mysql), not %s", dbConfig.Type), "")
+	}
+	if redisConfig != nil && redisConfig.Enabled {
+		return failure.User(fmt.Errorf("minimal dependency mode does not support Redis caching"), "")
+	}
+	if g.tracing {
+		return failure.User(fmt.Errorf("minimal dependency mode does not support OpenTelemetry tracing"), "")
+	}
+	return nil
+}
+
+// GoConfigFromToolchain derives the go and toolchain directives from a toolchain name such as go1.24.5
+func GoConfigFromToolchain(toolchain string) (*GoConfig, error) {
+	toolchain = strings.TrimSpace(toolchain)
+	if !goversion.IsValid(toolchain) {
+		return nil, fmt.Errorf("unrecognized Go toolchain: %s", toolchain)
+	}
+
+	return &GoConfig{
+		Version:   strings.TrimPrefix(goversion.Lang(toolchain), "go"),
+		Toolchain: toolchain,
+	}, nil
+}
+
+// DetectGoConfig returns the Go version of the go command on the PATH
+func DetectGoConfig() (*GoConfig, error) {
+	output, err := exec.Command("go", "env", "GOVERSION").Output()
+	if err != nil {
+		return nil, failure.Tool(fmt.Errorf("failed to detect Go version: %w", err), "install Go from https://go.dev/dl/ and make sure go is on your PATH")
+	}
+	return GoConfigFromToolchain(string(output))
+}
+
 // generateMetadata creates the gophex.md metadata file
 func (g *Generator) generateMetadata(projectType, projectName, projectPath string, dbConfig *DatabaseConfig, redisConfig *RedisConfig) error {
 	// Import metadata package here to avoid import cycle
@@ -27,11 +189,8 @@ func (g *Generator) generateMetadata(projectType, projectName, projectPath strin
 	return g.createMetadataFile(projectType, projectName, projectPath, dbConfig, redisConfig)
 }
 
-// createMetadataFile creates the metadata file without importing the metadata package
+// createMetadataFile creates the gophex.md metadata file for a freshly generated project
 func (g *Generator) createMetadataFile(projectType, projectName, projectPath string, dbConfig *DatabaseConfig, redisConfig *RedisConfig) error {
-	// For now, we'll create a simple implementation to avoid import cycles
-	// Later we can refactor this to use the metadata package properly
-
 	// Create a basic metadata structure
 	now := time.Now().Format(time.RFC3339)
 
@@ -44,14 +203,16 @@ This file contains project metadata and progress tracking for Gophex-generated p
 ` + "```json\n")
 
 	content += "{\n"
+	content += fmt.Sprintf("  \"schema_version\": %d,\n", utils.CurrentSchemaVersion)
 	content += fmt.Sprintf(`  "project": {
     "name": "%s",
     "type": "%s",
     "version": "1.0.0",
-    "gophex_version": "1.0.0",
+    "module_path": "%s",
+    "gophex_version": "%s",
     "generated_at": "%s",
     "last_updated": "%s"
-  },`, projectName, projectType, now, now)
+  },`, projectName, projectType, templates.GenerateModuleName(projectName), version.GetVersion(), now, now)
 
 	content += "\n  \"hierarchy\": {},\n"
 
@@ -177,17 +338,63 @@ This file contains project metadata and progress tracking for Gophex-generated p
     "graceful_shutdown": true,
     "lightweight_design": true`
 	case "cli":
-		content += `    "cobra_framework": true,
-    "command_line_interface": true,
+		if !g.minimalDeps {
+			content += `    "cobra_framework": true,
+`
+		}
+		content += `    "command_line_interface": true,
     "subcommands": true`
 	}
-	content += "\n  }\n"
+	if g.minimalDeps {
+		content += `,
+    "minimal_dependencies": true`
+	}
+	if g.tracing && projectType == "api" {
+		content += `,
+    "opentelemetry_tracing": true,
+    "log_correlation": true`
+	}
+	content += "\n  }"
+
+	// Endpoints discovered from the generated routes and handlers
+	if projectType == "api" {
+		endpoints, err := metadata.ScanAPIEndpoints(projectPath)
+		if err != nil {
+			return fmt.Errorf("failed to scan API endpoints: %w", err)
+		}
+		if len(endpoints) > 0 {
+			endpointsJSON, err := json.MarshalIndent(endpoints, "  ", "  ")
+			if err != nil {
+				return fmt.Errorf("failed to marshal endpoints: %w", err)
+			}
+			content += ",\n  \"endpoints\": " + string(endpointsJSON)
+		}
+	}
+
+	// Manifest of generated files, used to detect local drift and by "gophex blame"
+	manifest, err := metadata.BuildFileManifest(projectPath)
+	if err != nil {
+		return err
+	}
+	for relPath, record := range manifest {
+		if source, ok := g.origins[relPath]; ok {
+			record.Template = source
+			record.GophexVersion = version.GetVersion()
+			record.GeneratedAt = now
+			manifest[relPath] = record
+		}
+	}
+	manifestJSON, err := json.MarshalIndent(manifest, "  ", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal file manifest: %w", err)
+	}
+	content += ",\n  \"files\": " + string(manifestJSON)
 
-	content += "}\n```\n"
+	content += "\n}\n```\n"
 
 	// Write to file
 	filePath := filepath.Join(projectPath, "gophex.md")
-	err := os.WriteFile(filePath, []byte(content), 0644)
+	err = os.WriteFile(filePath, []byte(content), 0644)
 	if err != nil {
 		return fmt.Errorf("failed to write metadata file: %w", err)
 	}
@@ -208,10 +415,22 @@ func (g *Generator) GenerateWithFullConfig(projectType, projectName, projectPath
 }
 
 func (g *Generator) GenerateWithFramework(projectType, projectName, projectPath, framework string, dbConfig *DatabaseConfig, redisConfig *RedisConfig) error {
+	if _, err := g.dockerTemplateConfig(); err != nil {
+		return err
+	}
+	if _, err := g.goTemplateConfig(); err != nil {
+		return err
+	}
+	if err := g.validateMinimalDeps(projectType, framework, dbConfig, redisConfig); err != nil {
+		return err
+	}
+
 	if err := os.MkdirAll(projectPath, 0755); err != nil {
-		return fmt.Errorf("failed to create project directory: %w", err)
+		return failure.Environment(fmt.Errorf("failed to create project directory: %w", err), "check that the parent directory exists and is writable")
 	}
 
+	g.origins = make(map[string]string)
+
 	var err error
 	switch projectType {
 	case "api":
@@ -223,7 +442,7 @@ func (g *Generator) GenerateWithFramework(projectType, projectName, projectPath,
 	case "cli":
 		err = g.generateCLI(projectName, projectPath)
 	default:
-		return fmt.Errorf("unsupported project type: %s", projectType)
+		return failure.User(fmt.Errorf("unsupported project type: %s", projectType), "")
 	}
 
 	if err != nil {
@@ -278,9 +497,22 @@ func (g *Generator) createFromTemplateWithFramework(templateType, projectName, p
 		ModuleName:    templates.GenerateModuleName(projectName),
 		Framework:     framework, // Add framework information
 		GeneratedAt:   time.Now().Format(time.RFC3339),
-		GophexVersion: "1.0.0", // TODO: Get from version package
-		Checksums:     make(map[string]string),
+		GophexVersion: version.GetVersion(),
+		MinimalDeps:   g.minimalDeps,
+		Tracing:       g.tracing,
+	}
+
+	dockerConfig, err := g.dockerTemplateConfig()
+	if err != nil {
+		return err
+	}
+	data.Docker = dockerConfig
+
+	goConfig, err := g.goTemplateConfig()
+	if err != nil {
+		return err
 	}
+	data.Go = goConfig
 
 	// Add database configuration if provided
 	if dbConfig != nil {
@@ -301,6 +533,9 @@ func (g *Generator) createFromTemplateWithFramework(templateType, projectName, p
 		}
 	}
 
+	// Generated migration scripts point at the same pinned golang-migrate release Gophex installs
+	data.MigrateInstall = utils.GolangMigrate.InstallCommand(utils.MigrateDriverTag(data.DatabaseConfig.Type))
+
 	// Add Redis configuration if provided
 	if redisConfig != nil {
 		data.RedisConfig = templates.RedisConfig{
@@ -318,8 +553,12 @@ func (g *Generator) createFromTemplateWithFramework(templateType, projectName, p
 			(strings.Contains(file.Path, "/redis/") || strings.Contains(file.Path, "redis")) {
 			continue
 		}
+		// Skip the tracer setup and tracing middleware unless tracing is enabled
+		if !g.tracing && path.Base(file.Path) == "tracing.go" {
+			continue
+		}
 
-		filePath := filepath.Join(projectPath, file.Path)
+		filePath := filepath.Join(projectPath, filepath.FromSlash(file.Path))
 
 		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
 			return fmt.Errorf("failed to create directory for %s: %w", filePath, err)
@@ -328,12 +567,13 @@ func (g *Generator) createFromTemplateWithFramework(templateType, projectName, p
 		// Process template with proper template engine
 		content, err := templates.ProcessTemplate(file.Content, data)
 		if err != nil {
-			return fmt.Errorf("failed to process template for %s: %w", file.Path, err)
+			return failure.Template(fmt.Errorf("failed to process template for %s: %w", file.Path, err), failure.TemplateBugHint)
 		}
 
-		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		if err := os.WriteFile(filePath, []byte(nativeLineEndings(file.Path, content)), fileMode(file.Path)); err != nil {
 			return fmt.Errorf("failed to write file %s: %w", filePath, err)
 		}
+		g.origins[file.Path] = file.Source
 	}
 
 	return nil
@@ -352,10 +592,22 @@ func (g *Generator) createFromTemplate(templateType, projectName, projectPath st
 		Title:         projectName, // Set Title as alias for ProjectName
 		ModuleName:    templates.GenerateModuleName(projectName),
 		GeneratedAt:   time.Now().Format(time.RFC3339),
-		GophexVersion: "1.0.0", // TODO: Get from version package
-		Checksums:     make(map[string]string),
+		GophexVersion: version.GetVersion(),
+		MinimalDeps:   g.minimalDeps,
 	}
 
+	dockerConfig, err := g.dockerTemplateConfig()
+	if err != nil {
+		return err
+	}
+	data.Docker = dockerConfig
+
+	goConfig, err := g.goTemplateConfig()
+	if err != nil {
+		return err
+	}
+	data.Go = goConfig
+
 	// Add database configuration if provided
 	if dbConfig != nil {
 		data.DatabaseConfig = templates.DatabaseConfig{
@@ -375,6 +627,9 @@ func (g *Generator) createFromTemplate(templateType, projectName, projectPath st
 		}
 	}
 
+	// Generated migration scripts point at the same pinned golang-migrate release Gophex installs
+	data.MigrateInstall = utils.GolangMigrate.InstallCommand(utils.MigrateDriverTag(data.DatabaseConfig.Type))
+
 	// Add Redis configuration if provided
 	if redisConfig != nil {
 		data.RedisConfig = templates.RedisConfig{
@@ -393,7 +648,7 @@ func (g *Generator) createFromTemplate(templateType, projectName, projectPath st
 			continue
 		}
 
-		filePath := filepath.Join(projectPath, file.Path)
+		filePath := filepath.Join(projectPath, filepath.FromSlash(file.Path))
 
 		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
 			return fmt.Errorf("failed to create directory for %s: %w", filePath, err)
@@ -402,13 +657,31 @@ func (g *Generator) createFromTemplate(templateType, projectName, projectPath st
 		// Process template with proper template engine
 		content, err := templates.ProcessTemplate(file.Content, data)
 		if err != nil {
-			return fmt.Errorf("failed to process template for %s: %w", file.Path, err)
+			return failure.Template(fmt.Errorf("failed to process template for %s: %w", file.Path, err), failure.TemplateBugHint)
 		}
 
-		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+		if err := os.WriteFile(filePath, []byte(nativeLineEndings(file.Path, content)), fileMode(file.Path)); err != nil {
 			return fmt.Errorf("failed to write file %s: %w", filePath, err)
 		}
+		g.origins[file.Path] = file.Source
 	}
 
 	return nil
 }
+
+// fileMode returns the permissions for a generated file; shell scripts are executable
+func fileMode(path string) os.FileMode {
+	if strings.HasSuffix(path, ".sh") {
+		return 0755
+	}
+	return 0644
+}
+
+// nativeLineEndings converts Windows batch files to CRLF line endings, which cmd.exe
+// needs to resolve labels such as "goto" targets reliably
+func nativeLineEndings(path, content string) string {
+	if !strings.HasSuffix(path, ".bat") {
+		return content
+	}
+	return strings.ReplaceAll(strings.ReplaceAll(content, "\r\n", "\n"), "\n", "\r\n")
+}
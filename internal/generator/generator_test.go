@@ -1,9 +1,21 @@
 package generator
 
 import (
+	"fmt"
+	"go/parser"
+	"go/token"
+	goversion "go/version"
 	"os"
 	"path/filepath"
+	"reflect"
+	"regexp"
+	"runtime"
+	"strings"
 	"testing"
+
+	"github.com/buildwithhp/gophex/internal/deps"
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/utils"
 )
 
 func TestGenerator_Generate(t *testing.T) {
@@ -77,6 +89,21 @@ func TestGenerator_GenerateWithDatabaseConfig(t *testing.T) {
 	if _, err := os.Stat(configFile); os.IsNotExist(err) {
 		t.Fatal("Config file was not created")
 	}
+
+	// Check that endpoints were recorded in the metadata with auth information
+	metadataContent, err := os.ReadFile(filepath.Join(projectPath, "gophex.md"))
+	if err != nil {
+		t.Fatalf("Failed to read gophex.md: %v", err)
+	}
+	if !contains(string(metadataContent), `"path": "/api/v1/users/{id}"`) {
+		t.Error("Expected scanned endpoints in gophex.md")
+	}
+	if !contains(string(metadataContent), `"auth": "bearer"`) {
+		t.Error("Expected protected endpoints to use bearer auth")
+	}
+	if !contains(string(metadataContent), `"module_path": "`) {
+		t.Error("Expected the module path to be recorded in gophex.md")
+	}
 }
 
 func TestGenerator_GenerateWithFullConfig(t *testing.T) {
@@ -209,6 +236,623 @@ func TestGenerator_UnsupportedProjectType(t *testing.T) {
 	}
 }
 
+func TestGenerator_PlatformScripts(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gophex-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	projectPath := filepath.Join(tempDir, "testproject")
+	if err := New().Generate("api", "testproject", projectPath); err != nil {
+		t.Fatalf("Failed to generate API project: %v", err)
+	}
+
+	// Every shell script has a Windows-native batch equivalent
+	for _, base := range []string{"migrate", "detect-changes"} {
+		batchFile := filepath.Join(projectPath, "scripts", base+".bat")
+		content, err := os.ReadFile(batchFile)
+		if err != nil {
+			t.Fatalf("Expected %s.bat to be generated: %v", base, err)
+		}
+		if strings.Count(string(content), "\n") != strings.Count(string(content), "\r\n") {
+			t.Errorf("Expected %s.bat to use CRLF line endings", base)
+		}
+
+		shellScript := filepath.Join(projectPath, "scripts", base+".sh")
+		info, err := os.Stat(shellScript)
+		if err != nil {
+			t.Fatalf("Expected %s.sh to be generated: %v", base, err)
+		}
+		if runtime.GOOS != "windows" && info.Mode().Perm()&0111 == 0 {
+			t.Errorf("Expected %s.sh to be executable", base)
+		}
+	}
+}
+
+func TestGenerator_SmokeScript(t *testing.T) {
+	for _, framework := range []string{"", "gin", "echo", "gorilla"} {
+		t.Run("framework="+framework, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			if err := New().GenerateWithFramework("api", "testproject", projectPath, framework, nil, nil); err != nil {
+				t.Fatalf("Failed to generate API project: %v", err)
+			}
+
+			smokeScript := filepath.Join(projectPath, "scripts", "smoke.go")
+			file, err := parser.ParseFile(token.NewFileSet(), smokeScript, nil, parser.ParseComments)
+			if err != nil {
+				t.Fatalf("Expected scripts/smoke.go to be valid Go: %v", err)
+			}
+			if file.Name.Name != "main" {
+				t.Errorf("Expected package main, got %s", file.Name.Name)
+			}
+
+			// The script must be excluded from the project's own build
+			content, err := os.ReadFile(smokeScript)
+			if err != nil {
+				t.Fatalf("Failed to read smoke script: %v", err)
+			}
+			if !strings.HasPrefix(string(content), "//go:build ignore") {
+				t.Error("Expected scripts/smoke.go to start with a //go:build ignore constraint")
+			}
+		})
+	}
+}
+
+func TestGenerator_EnvironmentProfiles(t *testing.T) {
+	for _, framework := range []string{"", "gin", "echo", "gorilla"} {
+		t.Run("framework="+framework, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			if err := New().GenerateWithFramework("api", "testproject", projectPath, framework, nil, nil); err != nil {
+				t.Fatalf("Failed to generate API project: %v", err)
+			}
+
+			profileFile := filepath.Join(projectPath, "internal", "config", "profile.go")
+			if _, err := parser.ParseFile(token.NewFileSet(), profileFile, nil, 0); err != nil {
+				t.Fatalf("Expected internal/config/profile.go to be valid Go: %v", err)
+			}
+
+			envContent, err := os.ReadFile(filepath.Join(projectPath, ".env"))
+			if err != nil {
+				t.Fatalf("Failed to read .env: %v", err)
+			}
+			if !strings.Contains(string(envContent), "ENVIRONMENT=development") {
+				t.Error("Expected .env to select the development profile")
+			}
+			// Profile defaults only apply when .env does not pin the middleware settings
+			if strings.Contains(string(envContent), "\nCORS_ALLOWED_ORIGINS=") {
+				t.Error("Expected CORS_ALLOWED_ORIGINS to be left to the profile")
+			}
+
+			routesContent, err := os.ReadFile(filepath.Join(projectPath, "internal", "api", "routes", "routes.go"))
+			if err != nil {
+				t.Fatalf("Failed to read routes.go: %v", err)
+			}
+			if !strings.Contains(string(routesContent), "middleware.NewCORSMiddleware(cfg.CORS)") {
+				t.Error("Expected routes to build CORS middleware from the profile configuration")
+			}
+		})
+	}
+}
+
+func TestGenerator_DockerRuntimeImages(t *testing.T) {
+	tests := []struct {
+		image    string
+		expected []string
+	}{
+		{"distroless", []string{"FROM gcr.io/distroless/static-debian12:nonroot", "USER nonroot:nonroot"}},
+		{"alpine", []string{"FROM alpine:", "adduser -S", "USER app:app"}},
+		{"scratch", []string{"FROM scratch", "/etc/passwd", "USER 10001:10001"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.image, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			gen := New().WithDockerConfig(&DockerConfig{RuntimeImage: test.image, Platforms: []string{"linux/amd64", "linux/arm64"}})
+			if err := gen.GenerateWithFramework("api", "testproject", projectPath, "gin", nil, nil); err != nil {
+				t.Fatalf("Failed to generate API project: %v", err)
+			}
+
+			dockerfile, err := os.ReadFile(filepath.Join(projectPath, "Dockerfile"))
+			if err != nil {
+				t.Fatalf("Expected a Dockerfile: %v", err)
+			}
+			for _, expected := range append(test.expected, "FROM --platform=$BUILDPLATFORM", "HEALTHCHECK", `CMD ["/healthcheck"]`) {
+				if !strings.Contains(string(dockerfile), expected) {
+					t.Errorf("Expected Dockerfile to contain %q", expected)
+				}
+			}
+
+			makefile, err := os.ReadFile(filepath.Join(projectPath, "Makefile"))
+			if err != nil {
+				t.Fatalf("Expected a Makefile: %v", err)
+			}
+			if !strings.Contains(string(makefile), "PLATFORMS ?= linux/amd64,linux/arm64") {
+				t.Error("Expected Makefile to target the selected platforms")
+			}
+
+			workflow, err := os.ReadFile(filepath.Join(projectPath, ".github", "workflows", "ci.yml"))
+			if err != nil {
+				t.Fatalf("Expected a CI workflow: %v", err)
+			}
+			if !strings.Contains(string(workflow), "${{ env.PLATFORMS }}") {
+				t.Error("Expected GitHub expressions to survive template processing")
+			}
+
+			for _, file := range []string{".dockerignore", filepath.Join("cmd", "healthcheck", "main.go")} {
+				if _, err := os.Stat(filepath.Join(projectPath, file)); err != nil {
+					t.Errorf("Expected %s to be generated: %v", file, err)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_UnsupportedRuntimeImage(t *testing.T) {
+	projectPath := filepath.Join(t.TempDir(), "testproject")
+	err := New().WithDockerConfig(&DockerConfig{RuntimeImage: "ubuntu"}).GenerateWithFramework("api", "testproject", projectPath, "gin", nil, nil)
+	if err == nil {
+		t.Fatal("Expected error for unsupported runtime image")
+	}
+
+	expectedError := "unsupported runtime image: ubuntu"
+	if err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%s'", expectedError, err.Error())
+	}
+	if _, err := os.Stat(projectPath); !os.IsNotExist(err) {
+		t.Error("Expected no project directory to be created")
+	}
+}
+
+var (
+	// uses: docker/login-action@c94ce9f... # v3.7.0
+	actionPattern = regexp.MustCompile(`uses: ([\w.-]+/[\w.-]+)@(\S+)`)
+	commitPattern = regexp.MustCompile(`^[0-9a-f]{40}$`)
+)
+
+func TestGenerator_SupplyChainHardening(t *testing.T) {
+	projectPath := filepath.Join(t.TempDir(), "testproject")
+	dbConfig := &DatabaseConfig{Type: "postgresql", ConfigType: "single", Host: "localhost", Port: "5432", Username: "testuser", Password: "testpass", DatabaseName: "testapi", SSLMode: "disable"}
+	if err := New().GenerateWithFramework("api", "testproject", projectPath, "echo", dbConfig, nil); err != nil {
+		t.Fatalf("Failed to generate API project: %v", err)
+	}
+
+	expected := map[string][]string{
+		"Dockerfile": {"go mod verify", "-trimpath -buildvcs=false", "-buildid="},
+		"Makefile":   {"BUILD_FLAGS ?= -trimpath -buildvcs=false", "go build $(BUILD_FLAGS)", "reproducible:"},
+		filepath.Join(".github", "workflows", "ci.yml"): {
+			"id-token: write",
+			`git status --porcelain -- go.mod go.sum`,
+			"sigstore/cosign-installer@398d4b0eeef1380460a10c8013a76f728fb906ac # v3.9.1",
+			"cosign sign --yes",
+			"${{ steps.build.outputs.digest }}",
+			"make reproducible",
+		},
+		filepath.Join("scripts", "migrate.sh"): {"go install -tags 'postgres' " + utils.GolangMigrate.Target()},
+	}
+
+	for file, snippets := range expected {
+		content, err := os.ReadFile(filepath.Join(projectPath, file))
+		if err != nil {
+			t.Fatalf("Expected %s to be generated: %v", file, err)
+		}
+		for _, snippet := range snippets {
+			if !strings.Contains(string(content), snippet) {
+				t.Errorf("Expected %s to contain %q", file, snippet)
+			}
+		}
+		if strings.Contains(string(content), "migrate@latest") {
+			t.Errorf("Expected %s not to install golang-migrate@latest", file)
+		}
+	}
+
+	workflow, err := os.ReadFile(filepath.Join(projectPath, ".github", "workflows", "ci.yml"))
+	if err != nil {
+		t.Fatalf("Expected a CI workflow: %v", err)
+	}
+	if strings.Contains(string(workflow), "run: go mod tidy\n") {
+		t.Error("Expected CI to check go.mod is tidy instead of rewriting it")
+	}
+	for _, match := range actionPattern.FindAllStringSubmatch(string(workflow), -1) {
+		if !strings.HasPrefix(match[1], "actions/") && !commitPattern.MatchString(match[2]) {
+			t.Errorf("Expected third-party action %s to be pinned to a commit, got %s", match[1], match[2])
+		}
+	}
+}
+
+func TestGenerator_GoVersion(t *testing.T) {
+	tests := []struct {
+		name        string
+		goConfig    *GoConfig
+		expected    []string
+		notExpected []string
+	}{
+		{
+			name:        "default",
+			goConfig:    nil,
+			expected:    []string{"go " + DefaultGoVersion + "\n", "http.NewServeMux()"},
+			notExpected: []string{"toolchain", "gorilla/mux"},
+		},
+		{
+			name:        "go 1.21 keeps gorilla/mux",
+			goConfig:    &GoConfig{Version: "1.21"},
+			expected:    []string{"go 1.21\n", "github.com/gorilla/mux", "mux.NewRouter()"},
+			notExpected: []string{"toolchain", "http.NewServeMux()"},
+		},
+		{
+			name:        "go 1.23 with toolchain",
+			goConfig:    &GoConfig{Version: "1.23", Toolchain: "go1.24.5"},
+			expected:    []string{"go 1.23\n", "toolchain go1.24.5", `"GET /health"`},
+			notExpected: []string{"gorilla/mux"},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testservice")
+			if err := New().WithGoConfig(test.goConfig).GenerateWithFramework("microservice", "testservice", projectPath, "", nil, nil); err != nil {
+				t.Fatalf("Failed to generate microservice: %v", err)
+			}
+
+			var combined strings.Builder
+			for _, file := range []string{"go.mod", filepath.Join("cmd", "server", "main.go")} {
+				content, err := os.ReadFile(filepath.Join(projectPath, file))
+				if err != nil {
+					t.Fatalf("Expected %s to be generated: %v", file, err)
+				}
+				combined.Write(content)
+			}
+
+			for _, expected := range test.expected {
+				if !strings.Contains(combined.String(), expected) {
+					t.Errorf("Expected generated files to contain %q", expected)
+				}
+			}
+			for _, notExpected := range test.notExpected {
+				if strings.Contains(combined.String(), notExpected) {
+					t.Errorf("Expected generated files not to contain %q", notExpected)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_GoVersionDockerImage(t *testing.T) {
+	projectPath := filepath.Join(t.TempDir(), "testproject")
+	gen := New().WithGoConfig(&GoConfig{Version: "1.22", Toolchain: "go1.24.5"})
+	if err := gen.GenerateWithFramework("api", "testproject", projectPath, "gin", nil, nil); err != nil {
+		t.Fatalf("Failed to generate API project: %v", err)
+	}
+
+	dockerfile, err := os.ReadFile(filepath.Join(projectPath, "Dockerfile"))
+	if err != nil {
+		t.Fatalf("Expected a Dockerfile: %v", err)
+	}
+	if !strings.Contains(string(dockerfile), "ARG GO_VERSION=1.24\n") {
+		t.Error("Expected the Docker build stage to use the pinned toolchain's release")
+	}
+}
+
+func TestGenerator_InvalidGoVersion(t *testing.T) {
+	tests := []struct {
+		goConfig      *GoConfig
+		expectedError string
+	}{
+		{&GoConfig{Version: "1.20"}, "unsupported Go version: 1.20 (minimum is 1.21)"},
+		{&GoConfig{Version: "latest"}, "unsupported Go version: latest (minimum is 1.21)"},
+		{&GoConfig{Version: "1.24", Toolchain: "go1.22.1"}, "toolchain go1.22.1 is older than go 1.24"},
+		{&GoConfig{Version: "1.24", Toolchain: "1.24.5"}, "invalid toolchain: 1.24.5"},
+	}
+
+	for _, test := range tests {
+		projectPath := filepath.Join(t.TempDir(), "testproject")
+		err := New().WithGoConfig(test.goConfig).GenerateWithFramework("cli", "testproject", projectPath, "", nil, nil)
+		if err == nil || err.Error() != test.expectedError {
+			t.Errorf("Expected error '%s', got '%v'", test.expectedError, err)
+		}
+		if _, err := os.Stat(projectPath); !os.IsNotExist(err) {
+			t.Error("Expected no project directory to be created")
+		}
+	}
+}
+
+func TestGoConfigFromToolchain(t *testing.T) {
+	tests := []struct {
+		toolchain string
+		expected  *GoConfig
+	}{
+		{"go1.24.5\n", &GoConfig{Version: "1.24", Toolchain: "go1.24.5"}},
+		{"go1.23rc1", &GoConfig{Version: "1.23", Toolchain: "go1.23rc1"}},
+		{"devel go1.25-abcdef", nil},
+	}
+
+	for _, test := range tests {
+		config, err := GoConfigFromToolchain(test.toolchain)
+		if test.expected == nil {
+			if err == nil {
+				t.Errorf("Expected error for %q, got %+v", test.toolchain, config)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Expected no error for %q, got %v", test.toolchain, err)
+			continue
+		}
+		if *config != *test.expected {
+			t.Errorf("Expected %+v, got %+v", *test.expected, *config)
+		}
+	}
+}
+
+func TestDependencyCatalog_SupportsMinimumGoVersion(t *testing.T) {
+	for _, dep := range deps.All() {
+		// Compare language versions so a go 1.21.0 directive counts as Go 1.21
+		if dep.GoVersion != "" && goversion.Compare(goversion.Lang("go"+dep.GoVersion), "go"+MinimumGoVersion) > 0 {
+			t.Errorf("Expected %s %s to build with Go %s, but it requires Go %s", dep.Module, dep.Version, MinimumGoVersion, dep.GoVersion)
+		}
+	}
+}
+
+func TestGenerator_PinsCatalogVersions(t *testing.T) {
+	tests := []struct {
+		framework string
+		dbType    string
+		redis     bool
+		expected  []string
+	}{
+		{"gin", "postgresql", true, []string{"github.com/gin-gonic/gin", "github.com/lib/pq", "github.com/go-redis/redis/v8"}},
+		{"echo", "mysql", false, []string{"github.com/labstack/echo/v4", "github.com/go-sql-driver/mysql"}},
+		{"gorilla", "mongodb", false, []string{"github.com/gorilla/mux", "go.mongodb.org/mongo-driver"}},
+	}
+
+	for _, test := range tests {
+		t.Run(test.framework, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			dbConfig := &DatabaseConfig{Type: test.dbType, ConfigType: "single", Host: "localhost", Port: "5432", Username: "testuser", Password: "testpass", DatabaseName: "testapi"}
+			redisConfig := &RedisConfig{Enabled: test.redis, Host: "localhost", Port: "6379"}
+			if err := New().GenerateWithFramework("api", "testproject", projectPath, test.framework, dbConfig, redisConfig); err != nil {
+				t.Fatalf("Failed to generate API project: %v", err)
+			}
+
+			goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+			if err != nil {
+				t.Fatalf("Expected go.mod to be generated: %v", err)
+			}
+
+			for _, module := range append(test.expected, "github.com/golang-jwt/jwt/v5", "golang.org/x/crypto") {
+				line, err := deps.Require(module)
+				if err != nil {
+					t.Fatalf("Expected %s in the catalog: %v", module, err)
+				}
+				if !strings.Contains(string(goMod), line) {
+					t.Errorf("Expected go.mod to require %q", line)
+				}
+			}
+			if !test.redis && strings.Contains(string(goMod), "go-redis") {
+				t.Error("Expected no Redis client without Redis enabled")
+			}
+		})
+	}
+}
+
+func TestGenerator_RecordsProfileRateLimits(t *testing.T) {
+	expected := map[string]int{"development": 1000, "production": 60}
+
+	for _, test := range []struct {
+		framework   string
+		minimalDeps bool
+	}{{"", false}, {"gin", false}, {"echo", false}, {"gorilla", false}, {"", true}} {
+		name := test.framework
+		if test.minimalDeps {
+			name = "minimal"
+		} else if name == "" {
+			name = "default"
+		}
+
+		t.Run(name, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			dbConfig := &DatabaseConfig{Type: "postgresql", ConfigType: "single", Host: "localhost", Port: "5432", Username: "testuser", Password: "testpass", DatabaseName: "testapi"}
+			if err := New().WithMinimalDeps(test.minimalDeps).GenerateWithFramework("api", "testproject", projectPath, test.framework, dbConfig, &RedisConfig{}); err != nil {
+				t.Fatalf("Failed to generate API project: %v", err)
+			}
+
+			profile, err := os.ReadFile(filepath.Join(projectPath, "internal", "config", "profile.go"))
+			if err != nil {
+				t.Fatalf("Expected profile.go to be generated: %v", err)
+			}
+			for _, requests := range expected {
+				if !strings.Contains(string(profile), fmt.Sprintf("RequestsPerMinute: %d,", requests)) {
+					t.Fatalf("Expected profile.go to set a limit of %d requests per minute", requests)
+				}
+			}
+
+			projectMetadata, err := metadata.LoadMetadata(projectPath)
+			if err != nil {
+				t.Fatalf("Failed to load gophex.md: %v", err)
+			}
+			if len(projectMetadata.Endpoints) == 0 {
+				t.Fatal("Expected gophex.md to record endpoints")
+			}
+			for _, endpoint := range projectMetadata.Endpoints {
+				rateLimit := endpoint.RateLimit
+				if rateLimit == nil || rateLimit.Requests != expected["production"] || !reflect.DeepEqual(rateLimit.Profiles, expected) {
+					t.Errorf("Expected %s %s to record the profile limits %v, got %+v", endpoint.Method, endpoint.Path, expected, rateLimit)
+				}
+			}
+		})
+	}
+}
+
+func TestGenerator_MinimalDeps(t *testing.T) {
+	thirdParty := []string{"github.com/gorilla/mux", "github.com/golang-jwt/jwt", "gopkg.in/yaml.v3", "github.com/spf13/cobra", "github.com/gin-gonic/gin", "github.com/labstack/echo"}
+
+	tests := []struct {
+		projectType string
+		dbConfig    *DatabaseConfig
+		expected    []string
+	}{
+		{"api", &DatabaseConfig{Type: "postgresql", ConfigType: "single", Host: "localhost", Port: "5432", Username: "testuser", Password: "testpass", DatabaseName: "testapi"}, []string{"github.com/lib/pq", "golang.org/x/crypto"}},
+		{"api", &DatabaseConfig{Type: "mysql", ConfigType: "single", Host: "localhost", Port: "3306", Username: "testuser", Password: "testpass", DatabaseName: "testapi"}, []string{"github.com/go-sql-driver/mysql", "golang.org/x/crypto"}},
+		{"webapp", nil, nil},
+		{"microservice", nil, nil},
+		{"cli", nil, nil},
+	}
+
+	for _, test := range tests {
+		name := test.projectType
+		if test.dbConfig != nil {
+			name += "-" + test.dbConfig.Type
+		}
+
+		t.Run(name, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			if err := New().WithMinimalDeps(true).GenerateWithFramework(test.projectType, "testproject", projectPath, "", test.dbConfig, &RedisConfig{}); err != nil {
+				t.Fatalf("Failed to generate minimal %s project: %v", test.projectType, err)
+			}
+
+			err := filepath.WalkDir(projectPath, func(path string, d os.DirEntry, err error) error {
+				if err != nil || d.IsDir() || (filepath.Ext(path) != ".go" && d.Name() != "go.mod") {
+					return err
+				}
+				content, err := os.ReadFile(path)
+				if err != nil {
+					return err
+				}
+				for _, module := range thirdParty {
+					if strings.Contains(string(content), module) {
+						t.Errorf("Expected no %s in %s", module, strings.TrimPrefix(path, projectPath))
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				t.Fatalf("Failed to scan generated files: %v", err)
+			}
+
+			goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+			if err != nil {
+				t.Fatalf("Expected go.mod to be generated: %v", err)
+			}
+			for _, module := range test.expected {
+				if !strings.Contains(string(goMod), module) {
+					t.Errorf("Expected go.mod to require %s", module)
+				}
+			}
+
+			projectMetadata, err := os.ReadFile(filepath.Join(projectPath, "gophex.md"))
+			if err != nil {
+				t.Fatalf("Expected gophex.md to be generated: %v", err)
+			}
+			if !strings.Contains(string(projectMetadata), `"minimal_dependencies": true`) {
+				t.Error("Expected gophex.md to record the minimal_dependencies feature")
+			}
+		})
+	}
+}
+
+func TestGenerator_MinimalDepsUnsupportedOptions(t *testing.T) {
+	tests := []struct {
+		name          string
+		goConfig      *GoConfig
+		framework     string
+		dbConfig      *DatabaseConfig
+		redisConfig   *RedisConfig
+		expectedError string
+	}{
+		{"go 1.21", &GoConfig{Version: "1.21"}, "", nil, nil, "minimal dependency mode requires Go 1.22 or newer for net/http routing (selected 1.21)"},
+		{"framework", nil, "gin", nil, nil, "minimal dependency mode uses net/http routing and cannot be combined with the gin framework"},
+		{"mongodb", nil, "", &DatabaseConfig{Type: "mongodb"}, nil, "minimal dependency mode supports database/sql databases only (postgresql, mysql), not mongodb"},
+		{"redis", nil, "", nil, &RedisConfig{Enabled: true}, "minimal dependency mode does not support Redis caching"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			projectPath := filepath.Join(t.TempDir(), "testproject")
+			err := New().WithGoConfig(test.goConfig).WithMinimalDeps(true).GenerateWithFramework("api", "testproject", projectPath, test.framework, test.dbConfig, test.redisConfig)
+			if err == nil || err.Error() != test.expectedError {
+				t.Errorf("Expected error '%s', got '%v'", test.expectedError, err)
+			}
+			if _, err := os.Stat(projectPath); !os.IsNotExist(err) {
+				t.Error("Expected no project directory to be created")
+			}
+		})
+	}
+}
+
+func TestGenerator_Tracing(t *testing.T) {
+	otelModules := []string{"go.opentelemetry.io/otel", "go.opentelemetry.io/otel/sdk", "go.opentelemetry.io/otel/trace", "go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"}
+	tracingFiles := []string{"internal/pkg/tracing/tracing.go", "internal/api/middleware/tracing.go"}
+
+	for _, framework := range []string{"", "gin", "echo", "gorilla"} {
+		for _, tracing := range []bool{true, false} {
+			name := framework
+			if name == "" {
+				name = "default"
+			}
+			if !tracing {
+				name += "-without-tracing"
+			}
+
+			t.Run(name, func(t *testing.T) {
+				projectPath := filepath.Join(t.TempDir(), "testproject")
+				dbConfig := &DatabaseConfig{Type: "postgresql", ConfigType: "single", Host: "localhost", Port: "5432", Username: "testuser", Password: "testpass", DatabaseName: "testapi"}
+				if err := New().WithTracing(tracing).GenerateWithFramework("api", "testproject", projectPath, framework, dbConfig, &RedisConfig{}); err != nil {
+					t.Fatalf("Failed to generate API project: %v", err)
+				}
+
+				for _, file := range tracingFiles {
+					if _, err := os.Stat(filepath.Join(projectPath, file)); os.IsNotExist(err) == tracing {
+						t.Errorf("Expected %s to exist: %v, got the opposite", file, tracing)
+					}
+				}
+
+				goMod, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+				if err != nil {
+					t.Fatalf("Expected go.mod to be generated: %v", err)
+				}
+				for _, module := range otelModules {
+					line, err := deps.Require(module)
+					if err != nil {
+						t.Fatalf("Expected %s in the catalog: %v", module, err)
+					}
+					if strings.Contains(string(goMod), line) != tracing {
+						t.Errorf("Expected go.mod to require %q: %v, got the opposite", line, tracing)
+					}
+				}
+
+				logger, err := os.ReadFile(filepath.Join(projectPath, "internal", "pkg", "logger", "logger.go"))
+				if err != nil {
+					t.Fatalf("Expected logger.go to be generated: %v", err)
+				}
+				if strings.Contains(string(logger), "WithContext(ctx context.Context) Logger") != tracing {
+					t.Errorf("Expected the logger to correlate trace IDs: %v, got the opposite", tracing)
+				}
+
+				projectMetadata, err := os.ReadFile(filepath.Join(projectPath, "gophex.md"))
+				if err != nil {
+					t.Fatalf("Expected gophex.md to be generated: %v", err)
+				}
+				if strings.Contains(string(projectMetadata), `"opentelemetry_tracing": true`) != tracing {
+					t.Errorf("Expected gophex.md to record the opentelemetry_tracing feature: %v, got the opposite", tracing)
+				}
+			})
+		}
+	}
+}
+
+func TestGenerator_TracingUnsupportedWithMinimalDeps(t *testing.T) {
+	projectPath := filepath.Join(t.TempDir(), "testproject")
+	expectedError := "minimal dependency mode does not support OpenTelemetry tracing"
+
+	err := New().WithMinimalDeps(true).WithTracing(true).GenerateWithFramework("api", "testproject", projectPath, "", nil, nil)
+	if err == nil || err.Error() != expectedError {
+		t.Errorf("Expected error '%s', got '%v'", expectedError, err)
+	}
+	if _, err := os.Stat(projectPath); !os.IsNotExist(err) {
+		t.Error("Expected no project directory to be created")
+	}
+}
+
 func TestDatabaseConfig_AllTypes(t *testing.T) {
 	tests := []struct {
 		dbType     string
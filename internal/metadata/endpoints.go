@@ -0,0 +1,335 @@
+package metadata
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// Auth schemes recorded on endpoints
+const (
+	AuthSchemeNone   = "none"
+	AuthSchemeBearer = "bearer"
+)
+
+var (
+	// api := r.PathPrefix("/api/v1").Subrouter() / api := r.Group("/api/v1")
+	routeGroupPattern = regexp.MustCompile(`^(\w+)\s*:=\s*(\w+)\.(?:PathPrefix\("([^"]*)"\)\.Subrouter\(\)|Group\("([^"]*)"\))`)
+	// api.HandleFunc("/health", healthHandler.Health).Methods("GET")
+	muxRoutePattern = regexp.MustCompile(`^(\w+)\.HandleFunc\("([^"]*)",\s*\w+\.(\w+)\)\.Methods\("(\w+)"\)`)
+	// router.HandleFunc("GET /api/v1/health", healthHandler.Health) / router.Handle("GET /api/v1/users", protected(userHandler.GetUsers))
+	serveMuxRoutePattern = regexp.MustCompile(`^(\w+)\.Handle(?:Func)?\("(GET|POST|PUT|PATCH|DELETE) ([^"]*)",\s*(?:(\w+)\()?\w+\.(\w+)\)+\s*$`)
+	// protected := func(next http.HandlerFunc) http.Handler { return authMiddleware.RequireAuth(next) }
+	authWrapperPattern = regexp.MustCompile(`^(\w+)\s*:=\s*func\(.*RequireAuth`)
+	// api.GET("/health", gin.WrapF(healthHandler.Health))
+	methodRoutePattern = regexp.MustCompile(`^(\w+)\.(GET|POST|PUT|PATCH|DELETE)\("([^"]*)",.*?\w+\.(\w+)\)+\s*$`)
+	// protected.Use(...)
+	useMiddlewarePattern = regexp.MustCompile(`^(\w+)\.Use\(`)
+	// {id:[0-9]+} and :id path parameters
+	muxParamPattern = regexp.MustCompile(`\{(\w+):[^}]*\}`)
+	ginParamPattern = regexp.MustCompile(`:(\w+)`)
+	// RequestsPerMinute: 100,
+	rateLimitPattern = regexp.MustCompile(`RequestsPerMinute:\s*(\d+)`)
+	// ProfileProduction = "production"
+	profileNamePattern = regexp.MustCompile(`(Profile\w+)\s*=\s*"([^"]+)"`)
+	// ProfileProduction: { ... RateLimit: RateLimitConfig{ RequestsPerMinute: 60 } ... }
+	profileRateLimitPattern = regexp.MustCompile(`(?s)(Profile\w+):\s*\{.*?RequestsPerMinute:\s*(\d+)`)
+	// func (h *PostHandler) GetPosts(w http.ResponseWriter, r *http.Request) {
+	handlerFuncPattern = regexp.MustCompile(`^func \(\w+ \*\w+\) (\w+)\(`)
+	// var req CreatePostRequest
+	requestVarPattern = regexp.MustCompile(`^var req (\w+)`)
+	// @Success 200 {object} responses.SuccessResponse
+	successAnnotationPattern = regexp.MustCompile(`@Success\s+\d+\s+\{\w+\}\s+([\w.\[\]]+)`)
+)
+
+// handlerDoc holds the annotations collected from a handler function
+type handlerDoc struct {
+	Summary        string
+	Tags           []string
+	RequestSchema  string
+	ResponseSchema string
+	Secured        bool
+}
+
+// ScanAPIEndpoints builds endpoint metadata from the project's routes file and handler annotations.
+// It falls back to nil when the project has no routes file.
+func ScanAPIEndpoints(projectPath string) ([]EndpointInfo, error) {
+	routesPath := filepath.Join(projectPath, "internal", "api", "routes", "routes.go")
+	content, err := os.ReadFile(routesPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read routes file: %w", err)
+	}
+
+	endpoints, handlers := parseRoutes(string(content))
+
+	docs, err := scanHandlerDocs(filepath.Join(projectPath, "internal", "api", "handlers"))
+	if err != nil {
+		return nil, err
+	}
+
+	rateLimit := detectRateLimit(projectPath, string(content))
+
+	for i := range endpoints {
+		enrichEndpoint(&endpoints[i], docs[handlers[i]], rateLimit)
+	}
+
+	return endpoints, nil
+}
+
+// parseRoutes extracts endpoints and their handler function names from a gorilla/mux, Gin, Echo or net/http routes file
+func parseRoutes(content string) ([]EndpointInfo, []string) {
+	var endpoints []EndpointInfo
+	var handlers []string
+
+	prefixes := make(map[string]string)
+	protected := make(map[string]bool)
+	pendingUse := ""
+
+	scanner := bufio.NewScanner(strings.NewReader(content))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		// Multi-line Use(...) blocks (Gin) are closed by "})"
+		if pendingUse != "" {
+			if strings.Contains(line, "RequireAuth") {
+				protected[pendingUse] = true
+			}
+			if line == "})" {
+				pendingUse = ""
+			}
+			continue
+		}
+
+		if match := routeGroupPattern.FindStringSubmatch(line); match != nil {
+			prefixes[match[1]] = prefixes[match[2]] + match[3] + match[4]
+			protected[match[1]] = protected[match[2]]
+			continue
+		}
+
+		if match := authWrapperPattern.FindStringSubmatch(line); match != nil {
+			protected[match[1]] = true
+			continue
+		}
+
+		if match := useMiddlewarePattern.FindStringSubmatch(line); match != nil {
+			if strings.Contains(line, "RequireAuth") {
+				protected[match[1]] = true
+			} else if strings.HasSuffix(line, "{") {
+				pendingUse = match[1]
+			}
+			continue
+		}
+
+		var group, path, handler, method, wrapper string
+		if match := muxRoutePattern.FindStringSubmatch(line); match != nil {
+			group, path, handler, method = match[1], match[2], match[3], match[4]
+		} else if match := serveMuxRoutePattern.FindStringSubmatch(line); match != nil {
+			group, method, path, wrapper, handler = match[1], match[2], match[3], match[4], match[5]
+		} else if match := methodRoutePattern.FindStringSubmatch(line); match != nil {
+			group, method, path, handler = match[1], match[2], match[3], match[4]
+		} else {
+			continue
+		}
+
+		endpoints = append(endpoints, EndpointInfo{
+			Method:    strings.ToUpper(method),
+			Path:      normalizeRoutePath(prefixes[group] + path),
+			Protected: protected[group] || protected[wrapper],
+		})
+		handlers = append(handlers, handler)
+	}
+
+	return endpoints, handlers
+}
+
+// normalizeRoutePath rewrites router-specific path parameters to the {param} form
+func normalizeRoutePath(path string) string {
+	path = muxParamPattern.ReplaceAllString(path, "{$1}")
+	path = ginParamPattern.ReplaceAllString(path, "{$1}")
+	if path == "" {
+		return "/"
+	}
+	return path
+}
+
+// scanHandlerDocs collects swagger-style annotations and request types for every handler function
+func scanHandlerDocs(handlersDir string) (map[string]handlerDoc, error) {
+	docs := make(map[string]handlerDoc)
+
+	files, err := filepath.Glob(filepath.Join(handlersDir, "*.go"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to list handler files: %w", err)
+	}
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read handler file %s: %w", file, err)
+		}
+
+		var pending handlerDoc
+		current := ""
+
+		scanner := bufio.NewScanner(strings.NewReader(string(content)))
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+
+			switch {
+			case strings.HasPrefix(line, "// @Summary"):
+				pending.Summary = strings.TrimSpace(strings.TrimPrefix(line, "// @Summary"))
+			case strings.HasPrefix(line, "// @Tags"):
+				for _, tag := range strings.Split(strings.TrimPrefix(line, "// @Tags"), ",") {
+					if tag = strings.TrimSpace(tag); tag != "" {
+						pending.Tags = append(pending.Tags, tag)
+					}
+				}
+			case strings.HasPrefix(line, "// @Security"):
+				pending.Secured = true
+			case strings.HasPrefix(line, "// @Success"):
+				if match := successAnnotationPattern.FindStringSubmatch(line); match != nil {
+					pending.ResponseSchema = match[1]
+				}
+			default:
+				if match := handlerFuncPattern.FindStringSubmatch(line); match != nil {
+					current = match[1]
+					docs[current] = pending
+					pending = handlerDoc{}
+				} else if match := requestVarPattern.FindStringSubmatch(line); match != nil && current != "" {
+					doc := docs[current]
+					doc.RequestSchema = match[1]
+					docs[current] = doc
+				}
+			}
+		}
+	}
+
+	return docs, nil
+}
+
+// detectRateLimit returns the global rate limit applied by the generated rate limiting middleware.
+// Projects with environment profiles record the limit of each profile, and the production limit
+// as Requests; older projects read it from config.go. It returns nil when no limit is found.
+func detectRateLimit(projectPath, routesContent string) *RateLimitInfo {
+	if !strings.Contains(routesContent, "rateLimitMiddleware") {
+		return nil
+	}
+
+	configDir := filepath.Join(projectPath, "internal", "config")
+	if content, err := os.ReadFile(filepath.Join(configDir, "profile.go")); err == nil {
+		if profiles := profileRateLimits(string(content)); len(profiles) > 0 {
+			return &RateLimitInfo{Requests: profiles["production"], Window: time.Minute.String(), Profiles: profiles}
+		}
+	}
+
+	if content, err := os.ReadFile(filepath.Join(configDir, "config.go")); err == nil {
+		if match := rateLimitPattern.FindStringSubmatch(string(content)); match != nil {
+			if requests, err := strconv.Atoi(match[1]); err == nil {
+				return &RateLimitInfo{Requests: requests, Window: time.Minute.String()}
+			}
+		}
+	}
+
+	return nil
+}
+
+// profileRateLimits maps each environment profile declared in profile.go to its requests per minute
+func profileRateLimits(content string) map[string]int {
+	names := make(map[string]string)
+	for _, match := range profileNamePattern.FindAllStringSubmatch(content, -1) {
+		names[match[1]] = match[2]
+	}
+
+	limits := make(map[string]int)
+	for _, match := range profileRateLimitPattern.FindAllStringSubmatch(content, -1) {
+		name, ok := names[match[1]]
+		if !ok {
+			continue
+		}
+		if requests, err := strconv.Atoi(match[2]); err == nil {
+			limits[name] = requests
+		}
+	}
+	return limits
+}
+
+// enrichEndpoint fills in description, schemas, auth, tags and rate limit for an endpoint
+func enrichEndpoint(endpoint *EndpointInfo, doc handlerDoc, rateLimit *RateLimitInfo) {
+	if endpoint.Description == "" {
+		endpoint.Description = doc.Summary
+	}
+	if endpoint.RequestSchema == "" {
+		endpoint.RequestSchema = doc.RequestSchema
+	}
+	if endpoint.ResponseSchema == "" {
+		endpoint.ResponseSchema = doc.ResponseSchema
+	}
+	if doc.Secured {
+		endpoint.Protected = true
+	}
+	if endpoint.Auth == "" {
+		endpoint.Auth = AuthSchemeNone
+		if endpoint.Protected {
+			endpoint.Auth = AuthSchemeBearer
+		}
+	}
+	if len(endpoint.Tags) == 0 {
+		endpoint.Tags = doc.Tags
+	}
+	if len(endpoint.Tags) == 0 {
+		endpoint.Tags = tagsFromPath(endpoint.Path)
+	}
+	if endpoint.RateLimit == nil {
+		endpoint.RateLimit = rateLimit
+	}
+}
+
+// tagsFromPath derives a tag from the first resource segment of a path (e.g. /api/v1/posts/{id} -> posts)
+func tagsFromPath(path string) []string {
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" || segment == "api" || strings.HasPrefix(segment, "{") {
+			continue
+		}
+		if len(segment) > 1 && segment[0] == 'v' && strings.Trim(segment[1:], "0123456789") == "" {
+			continue
+		}
+		return []string{segment}
+	}
+	return nil
+}
+
+// AddEndpoints records endpoints in gophex.md, replacing existing entries with the same method and path
+func AddEndpoints(projectPath string, endpoints []EndpointInfo) error {
+	metadata, err := LoadMetadata(projectPath)
+	if err != nil {
+		return err
+	}
+
+	for _, endpoint := range endpoints {
+		replaced := false
+		for i, existing := range metadata.Endpoints {
+			if existing.Method == endpoint.Method && existing.Path == endpoint.Path {
+				metadata.Endpoints[i] = endpoint
+				replaced = true
+				break
+			}
+		}
+		if !replaced {
+			metadata.Endpoints = append(metadata.Endpoints, endpoint)
+		}
+	}
+
+	metadata.Project.LastUpdated = time.Now().Format(time.RFC3339)
+	metadata.SchemaVersion = utils.CurrentSchemaVersion
+
+	return utils.MergeMetadata(projectPath, metadata)
+}
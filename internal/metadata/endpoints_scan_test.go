@@ -0,0 +1,60 @@
+package metadata_test
+
+import (
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+	"github.com/buildwithhp/gophex/internal/testutil"
+)
+
+func TestScanAPIEndpointsEnrichment(t *testing.T) {
+	files := map[string]string{
+		"internal/api/routes/routes.go": `
+	rateLimitMiddleware := middleware.NewRateLimitMiddleware(cfg.RateLimit.RequestsPerMinute, time.Minute)
+	api := r.PathPrefix("/api/v1").Subrouter()
+	protected := api.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+	protected.HandleFunc("/posts", postHandler.CreatePost).Methods("POST")
+`,
+		"internal/api/handlers/posts.go": `
+// @Summary Create a new post
+// @Tags posts
+// @Security BearerAuth
+// @Success 201 {object} responses.SuccessResponse
+func (h *PostHandler) CreatePost(w http.ResponseWriter, r *http.Request) {
+	var req CreatePostRequest
+}
+`,
+		"internal/config/config.go": `RequestsPerMinute: 60,`,
+	}
+
+	tempDir := testutil.WriteProject(t, nil, files)
+
+	endpoints, err := metadata.ScanAPIEndpoints(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to scan endpoints: %v", err)
+	}
+	if len(endpoints) != 1 {
+		t.Fatalf("Expected 1 endpoint, got %d", len(endpoints))
+	}
+
+	endpoint := endpoints[0]
+	if endpoint.Description != "Create a new post" {
+		t.Errorf("Expected description from @Summary, got '%s'", endpoint.Description)
+	}
+	if endpoint.Auth != metadata.AuthSchemeBearer {
+		t.Errorf("Expected auth '%s', got '%s'", metadata.AuthSchemeBearer, endpoint.Auth)
+	}
+	if endpoint.RequestSchema != "CreatePostRequest" {
+		t.Errorf("Expected request schema 'CreatePostRequest', got '%s'", endpoint.RequestSchema)
+	}
+	if endpoint.ResponseSchema != "responses.SuccessResponse" {
+		t.Errorf("Expected response schema 'responses.SuccessResponse', got '%s'", endpoint.ResponseSchema)
+	}
+	if len(endpoint.Tags) != 1 || endpoint.Tags[0] != "posts" {
+		t.Errorf("Expected tags [posts], got %v", endpoint.Tags)
+	}
+	if endpoint.RateLimit == nil || endpoint.RateLimit.Requests != 60 {
+		t.Errorf("Expected rate limit of 60 requests, got %+v", endpoint.RateLimit)
+	}
+}
@@ -0,0 +1,132 @@
+package metadata
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseRoutes(t *testing.T) {
+	tests := []struct {
+		name   string
+		routes string
+	}{
+		{
+			name: "gorilla mux",
+			routes: `
+	api := r.PathPrefix("/api/v1").Subrouter()
+	api.HandleFunc("/health", healthHandler.Health).Methods("GET")
+	protected := api.PathPrefix("").Subrouter()
+	protected.Use(authMiddleware.RequireAuth)
+	protected.HandleFunc("/posts/{id:[0-9]+}", postHandler.UpdatePost).Methods("PUT")
+`,
+		},
+		{
+			name: "gin",
+			routes: `
+	api := r.Group("/api/v1")
+	api.GET("/health", gin.WrapF(healthHandler.Health))
+	protected := api.Group("")
+	protected.Use(func(c *gin.Context) {
+		authMiddleware.RequireAuth(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			c.Next()
+		})).ServeHTTP(c.Writer, c.Request)
+	})
+	protected.PUT("/posts/:id", gin.WrapF(postHandler.UpdatePost))
+`,
+		},
+		{
+			name: "echo",
+			routes: `
+	api := e.Group("/api/v1")
+	api.GET("/health", echo.WrapHandler(http.HandlerFunc(healthHandler.Health)))
+	protected := api.Group("")
+	protected.Use(echo.WrapMiddleware(authMiddleware.RequireAuth))
+	protected.PUT("/posts/:id", echo.WrapHandler(http.HandlerFunc(postHandler.UpdatePost)))
+`,
+		},
+		{
+			name: "net/http",
+			routes: `
+	router := http.NewServeMux()
+	protected := func(next http.HandlerFunc) http.Handler { return authMiddleware.RequireAuth(next) }
+	router.HandleFunc("GET /api/v1/health", healthHandler.Health)
+	router.Handle("PUT /api/v1/posts/{id}", protected(postHandler.UpdatePost))
+`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			endpoints, handlers := parseRoutes(test.routes)
+			if len(endpoints) != 2 {
+				t.Fatalf("Expected 2 endpoints, got %d", len(endpoints))
+			}
+
+			if endpoints[0].Path != "/api/v1/health" || endpoints[0].Protected || handlers[0] != "Health" {
+				t.Errorf("Unexpected health endpoint: %+v (handler %s)", endpoints[0], handlers[0])
+			}
+
+			if endpoints[1].Method != "PUT" || endpoints[1].Path != "/api/v1/posts/{id}" || !endpoints[1].Protected || handlers[1] != "UpdatePost" {
+				t.Errorf("Unexpected update endpoint: %+v (handler %s)", endpoints[1], handlers[1])
+			}
+		})
+	}
+}
+
+func TestDetectRateLimit(t *testing.T) {
+	routes := `rateLimitMiddleware := middleware.NewRateLimitMiddleware(cfg.RateLimit.RequestsPerMinute, time.Minute)`
+	profile := `const (
+	ProfileDevelopment = "development"
+	ProfileProduction  = "production"
+)
+
+var profiles = map[string]Profile{
+	ProfileDevelopment: {
+		Name: ProfileDevelopment,
+		RateLimit: RateLimitConfig{
+			RequestsPerMinute: 1000,
+		},
+	},
+	ProfileProduction: {
+		Name: ProfileProduction,
+		RateLimit: RateLimitConfig{
+			RequestsPerMinute: 60,
+		},
+	},
+}`
+
+	tests := []struct {
+		name     string
+		routes   string
+		files    map[string]string
+		expected *RateLimitInfo
+	}{
+		{"profiles", routes, map[string]string{"profile.go": profile, "config.go": `RequestsPerMinute int`},
+			&RateLimitInfo{Requests: 60, Window: "1m0s", Profiles: map[string]int{"development": 1000, "production": 60}}},
+		{"config literal", routes, map[string]string{"config.go": `RequestsPerMinute: 100,`}, &RateLimitInfo{Requests: 100, Window: "1m0s"}},
+		{"limit not found", routes, map[string]string{"config.go": `RequestsPerMinute int`}, nil},
+		{"no middleware", "", map[string]string{"profile.go": profile}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			configDir := filepath.Join(tempDir, "internal", "config")
+			if err := os.MkdirAll(configDir, 0755); err != nil {
+				t.Fatalf("Failed to create directory: %v", err)
+			}
+			for name, content := range test.files {
+				if err := os.WriteFile(filepath.Join(configDir, name), []byte(content), 0644); err != nil {
+					t.Fatalf("Failed to write %s: %v", name, err)
+				}
+			}
+
+			rateLimit := detectRateLimit(tempDir, test.routes)
+			if !reflect.DeepEqual(rateLimit, test.expected) {
+				t.Errorf("Expected rate limit %+v, got %+v", test.expected, rateLimit)
+			}
+		})
+	}
+}
@@ -0,0 +1,139 @@
+package metadata
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// Layers the CRUD generator can emit for an entity, innermost first
+const (
+	LayerDomain     = "domain"     // model, repository interface and service
+	LayerRepository = "repository" // database and in-memory repositories, migrations
+	LayerHTTP       = "http"       // handlers, routes and integration tests
+)
+
+// AllLayers lists every layer in generation order
+var AllLayers = []string{LayerDomain, LayerRepository, LayerHTTP}
+
+// HasLayer reports whether layer was generated for the entity
+func (e EntityInfo) HasLayer(layer string) bool {
+	if len(e.Layers) == 0 {
+		return true
+	}
+	for _, generated := range e.Layers {
+		if generated == layer {
+			return true
+		}
+	}
+	return false
+}
+
+// MissingLayers returns the layers that have not been generated for the entity yet
+func (e EntityInfo) MissingLayers() []string {
+	var missing []string
+	for _, layer := range AllLayers {
+		if !e.HasLayer(layer) {
+			missing = append(missing, layer)
+		}
+	}
+	return missing
+}
+
+// MergeLayers returns the union of two layer sets in generation order. An empty set
+// stands for every layer, so merging with it yields an empty set.
+func MergeLayers(a, b []string) []string {
+	if len(a) == 0 || len(b) == 0 {
+		return nil
+	}
+
+	merged := EntityInfo{Layers: append(append([]string{}, a...), b...)}
+	if len(merged.MissingLayers()) == 0 {
+		return nil
+	}
+
+	var layers []string
+	for _, layer := range AllLayers {
+		if merged.HasLayer(layer) {
+			layers = append(layers, layer)
+		}
+	}
+	return layers
+}
+
+// FindEntity returns the recorded entity with the given name
+func FindEntity(metadata *ProjectMetadata, name string) (EntityInfo, bool) {
+	for _, entity := range metadata.Entities {
+		if entity.Name == name {
+			return entity, true
+		}
+	}
+	return EntityInfo{}, false
+}
+
+// AddEntity records a generated entity in gophex.md, replacing an existing entry with the same name.
+// Layers accumulate across generations, so a partial entity can be completed later.
+func AddEntity(projectPath string, entity EntityInfo) error {
+	metadata, err := LoadMetadata(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if entity.GeneratedAt == "" {
+		entity.GeneratedAt = time.Now().Format(time.RFC3339)
+	}
+
+	replaced := false
+	for i, existing := range metadata.Entities {
+		if existing.Name == entity.Name {
+			entity.Layers = MergeLayers(existing.Layers, entity.Layers)
+			metadata.Entities[i] = entity
+			replaced = true
+			break
+		}
+	}
+	if !replaced {
+		metadata.Entities = append(metadata.Entities, entity)
+	}
+
+	metadata.Project.LastUpdated = time.Now().Format(time.RFC3339)
+	metadata.SchemaVersion = utils.CurrentSchemaVersion
+
+	return utils.MergeMetadata(projectPath, metadata)
+}
+
+// ListEntities returns the entities recorded in metadata plus any scaffolded
+// domain packages (internal/domain/<name>) that were not generated by the CRUD wizard
+func ListEntities(projectPath string, metadata *ProjectMetadata) ([]EntityInfo, error) {
+	entities := append([]EntityInfo(nil), metadata.Entities...)
+
+	known := make(map[string]bool)
+	for _, entity := range entities {
+		known[entity.Name] = true
+	}
+
+	domainDir := filepath.Join(projectPath, "internal", "domain")
+	entries, err := os.ReadDir(domainDir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return entities, nil
+		}
+		return nil, fmt.Errorf("failed to read domain directory: %w", err)
+	}
+
+	for _, entry := range entries {
+		if entry.IsDir() && !known[entry.Name()] {
+			entities = append(entities, EntityInfo{Name: entry.Name()})
+		}
+	}
+
+	sort.Slice(entities, func(i, j int) bool {
+		return entities[i].Name < entities[j].Name
+	})
+
+	return entities, nil
+}
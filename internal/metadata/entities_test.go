@@ -0,0 +1,38 @@
+package metadata
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestMergeLayers(t *testing.T) {
+	tests := []struct {
+		name     string
+		a, b     []string
+		expected []string
+	}{
+		{"both partial", []string{LayerHTTP}, []string{LayerDomain}, []string{LayerDomain, LayerHTTP}},
+		{"duplicates", []string{LayerDomain}, []string{LayerDomain}, []string{LayerDomain}},
+		{"all recorded", nil, []string{LayerDomain}, nil},
+		{"completed", []string{LayerDomain, LayerRepository}, []string{LayerHTTP}, nil},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if result := MergeLayers(test.a, test.b); !reflect.DeepEqual(result, test.expected) {
+				t.Errorf("Expected %v, got %v", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestMissingLayers(t *testing.T) {
+	entity := EntityInfo{Name: "product", Layers: []string{LayerDomain}}
+	if missing := entity.MissingLayers(); !reflect.DeepEqual(missing, []string{LayerRepository, LayerHTTP}) {
+		t.Errorf("Expected repository and http to be missing, got %v", missing)
+	}
+
+	if missing := (EntityInfo{Name: "user"}).MissingLayers(); len(missing) != 0 {
+		t.Errorf("Expected entities without recorded layers to be complete, got %v", missing)
+	}
+}
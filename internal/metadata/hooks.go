@@ -0,0 +1,125 @@
+package metadata
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// Hook events, the points in a project's life at which Gophex runs its hooks
+const (
+	HookPostGenerate = "post_generate" // after the project is generated
+	HookPostCRUD     = "post_crud"     // after CRUD code is generated for an entity
+	HookPreStart     = "pre_start"     // before the application is started
+)
+
+// HookEvents lists every hook event in the order they happen
+var HookEvents = []string{HookPostGenerate, HookPostCRUD, HookPreStart}
+
+// DefaultHookTimeout bounds a hook that does not set its own timeout
+const DefaultHookTimeout = 2 * time.Minute
+
+// HookCommand is a shell command Gophex runs in the project directory at a hook event
+type HookCommand struct {
+	Name    string            `json:"name,omitempty"`
+	Run     string            `json:"run"`
+	Env     map[string]string `json:"env,omitempty"`
+	Timeout string            `json:"timeout,omitempty"` // Go duration such as "30s"; defaults to DefaultHookTimeout
+}
+
+// DisplayName returns the hook's name, falling back to its command
+func (h HookCommand) DisplayName() string {
+	if h.Name != "" {
+		return h.Name
+	}
+	return h.Run
+}
+
+// TimeoutDuration returns how long the hook may run
+func (h HookCommand) TimeoutDuration() (time.Duration, error) {
+	if h.Timeout == "" {
+		return DefaultHookTimeout, nil
+	}
+
+	timeout, err := time.ParseDuration(h.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf("hook %q has invalid timeout %q (use a duration such as 30s or 5m)", h.DisplayName(), h.Timeout)
+	}
+	return timeout, nil
+}
+
+// ValidateHooks checks a hooks section for unknown events, empty commands and bad timeouts
+func ValidateHooks(hooks map[string][]HookCommand) error {
+	for event, commands := range hooks {
+		if !IsHookEvent(event) {
+			return fmt.Errorf("unknown hook event %q (use one of: %s)", event, strings.Join(HookEvents, ", "))
+		}
+		for i, hook := range commands {
+			if strings.TrimSpace(hook.Run) == "" {
+				return fmt.Errorf("%s hook #%d has no run command", event, i+1)
+			}
+			if _, err := hook.TimeoutDuration(); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// IsHookEvent reports whether event is a known hook event
+func IsHookEvent(event string) bool {
+	for _, known := range HookEvents {
+		if event == known {
+			return true
+		}
+	}
+	return false
+}
+
+// HookActivityName returns the activity that records the last run of an event's hooks
+func HookActivityName(event string) string {
+	return "hooks_" + event
+}
+
+// RecordHookRun records the outcome of running an event's hooks in the project activities
+func RecordHookRun(projectPath, event string, succeeded bool) error {
+	metadata, err := LoadMetadata(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Activities == nil {
+		metadata.Activities = make(map[string]ActivityInfo)
+	}
+
+	now := time.Now().Format(time.RFC3339)
+	metadata.Activities[HookActivityName(event)] = ActivityInfo{
+		Completed: succeeded,
+		Timestamp: now,
+		CanRepeat: true,
+	}
+	metadata.Project.LastUpdated = now
+
+	return SaveMetadata(projectPath, metadata)
+}
+
+// SetHooks replaces the project's hooks section
+func SetHooks(projectPath string, hooks map[string][]HookCommand) error {
+	if err := ValidateHooks(hooks); err != nil {
+		return err
+	}
+
+	metadata, err := LoadMetadata(projectPath)
+	if err != nil {
+		return err
+	}
+
+	metadata.Hooks = hooks
+	metadata.Project.LastUpdated = time.Now().Format(time.RFC3339)
+	metadata.SchemaVersion = utils.CurrentSchemaVersion
+
+	// Replace rather than merge, so events missing from hooks are removed
+	return utils.MergeMetadata(projectPath, metadata, "hooks")
+}
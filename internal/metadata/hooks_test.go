@@ -0,0 +1,78 @@
+package metadata
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestValidateHooks(t *testing.T) {
+	tests := []struct {
+		name    string
+		hooks   map[string][]HookCommand
+		wantErr string
+	}{
+		{"no hooks", nil, ""},
+		{"valid hooks", map[string][]HookCommand{
+			HookPostGenerate: {{Run: "git init"}},
+			HookPreStart:     {{Name: "lint", Run: "go vet ./...", Timeout: "30s"}},
+		}, ""},
+		{"unknown event", map[string][]HookCommand{"post_deploy": {{Run: "true"}}}, "unknown hook event"},
+		{"empty command", map[string][]HookCommand{HookPostCRUD: {{Name: "fmt", Run: "  "}}}, "no run command"},
+		{"bad timeout", map[string][]HookCommand{HookPreStart: {{Run: "true", Timeout: "soon"}}}, "invalid timeout"},
+		{"negative timeout", map[string][]HookCommand{HookPreStart: {{Run: "true", Timeout: "-1s"}}}, "invalid timeout"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateHooks(tt.hooks)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("Expected no error, got %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestHookTimeoutDuration(t *testing.T) {
+	timeout, err := HookCommand{Run: "true"}.TimeoutDuration()
+	if err != nil || timeout != DefaultHookTimeout {
+		t.Errorf("Expected default timeout %s, got %s (%v)", DefaultHookTimeout, timeout, err)
+	}
+
+	timeout, err = HookCommand{Run: "true", Timeout: "90s"}.TimeoutDuration()
+	if err != nil || timeout != 90*time.Second {
+		t.Errorf("Expected 1m30s, got %s (%v)", timeout, err)
+	}
+}
+
+func TestSetHooksReplacesEvents(t *testing.T) {
+	projectPath := t.TempDir()
+	if err := SaveMetadata(projectPath, &ProjectMetadata{Project: ProjectInfo{Name: "shop", Type: "api"}}); err != nil {
+		t.Fatalf("Failed to write metadata: %v", err)
+	}
+
+	hooks := map[string][]HookCommand{
+		HookPostGenerate: {{Run: "make tidy"}},
+		HookPreStart:     {{Run: "make lint"}},
+	}
+	if err := SetHooks(projectPath, hooks); err != nil {
+		t.Fatalf("Failed to set hooks: %v", err)
+	}
+	if err := SetHooks(projectPath, map[string][]HookCommand{HookPreStart: {{Run: "make test"}}}); err != nil {
+		t.Fatalf("Failed to replace hooks: %v", err)
+	}
+
+	metadata, err := LoadMetadata(projectPath)
+	if err != nil {
+		t.Fatalf("Failed to load metadata: %v", err)
+	}
+	if len(metadata.Hooks) != 1 || len(metadata.Hooks[HookPreStart]) != 1 || metadata.Hooks[HookPreStart][0].Run != "make test" {
+		t.Errorf("Expected only the new pre_start hook, got %+v", metadata.Hooks)
+	}
+	if metadata.Project.Name != "shop" {
+		t.Errorf("Expected project name 'shop', got '%s'", metadata.Project.Name)
+	}
+}
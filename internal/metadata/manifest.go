@@ -0,0 +1,144 @@
+package metadata
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// FileRecord is the manifest entry for a generated file
+type FileRecord struct {
+	Checksum      string `json:"checksum"`
+	Template      string `json:"template,omitempty"` // template the file was rendered from
+	GophexVersion string `json:"gophex_version,omitempty"`
+	GeneratedAt   string `json:"generated_at,omitempty"`
+}
+
+// File states reported by FileStatus
+const (
+	FileUnmodified = "unmodified"
+	FileModified   = "modified"
+	FileMissing    = "missing"
+)
+
+// manifestExcluded lists files that are expected to change after generation
+var manifestExcluded = map[string]bool{
+	"gophex.md": true,
+	".env":      true,
+	"go.sum":    true,
+}
+
+// BuildFileManifest computes a checksum for every generated file in the project
+func BuildFileManifest(projectPath string) (map[string]FileRecord, error) {
+	manifest := make(map[string]FileRecord)
+
+	err := filepath.Walk(projectPath, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		relPath, err := filepath.Rel(projectPath, path)
+		if err != nil {
+			return err
+		}
+		relPath = filepath.ToSlash(relPath)
+
+		if info.IsDir() {
+			if relPath != "." && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+
+		if manifestExcluded[relPath] || strings.HasPrefix(relPath, "gophex.md.") {
+			return nil
+		}
+
+		checksum, err := FileChecksum(path)
+		if err != nil {
+			return err
+		}
+		manifest[relPath] = FileRecord{Checksum: checksum}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to build file manifest: %w", err)
+	}
+
+	return manifest, nil
+}
+
+// FileChecksum returns the sha256 checksum of a file in "sha256:<hex>" form
+func FileChecksum(path string) (string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	if _, err := io.Copy(hash, file); err != nil {
+		return "", err
+	}
+
+	return "sha256:" + hex.EncodeToString(hash.Sum(nil)), nil
+}
+
+// FileStatus compares a generated file on disk with its manifest record
+func FileStatus(projectPath, relPath string, record FileRecord) (string, error) {
+	checksum, err := FileChecksum(filepath.Join(projectPath, filepath.FromSlash(relPath)))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return FileMissing, nil
+		}
+		return "", fmt.Errorf("failed to checksum %s: %w", relPath, err)
+	}
+	if checksum != record.Checksum {
+		return FileModified, nil
+	}
+	return FileUnmodified, nil
+}
+
+// RecordFiles adds or replaces manifest entries in gophex.md
+func RecordFiles(projectPath string, records map[string]FileRecord) error {
+	metadata, err := LoadMetadata(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if metadata.Files == nil {
+		metadata.Files = make(map[string]FileRecord)
+	}
+	for relPath, record := range records {
+		metadata.Files[relPath] = record
+	}
+
+	return SaveMetadata(projectPath, metadata)
+}
+
+// DetectDrift compares the manifest with the files on disk and returns the modified and missing paths
+func DetectDrift(projectPath string, files map[string]FileRecord) ([]string, []string, error) {
+	var modified, missing []string
+
+	for relPath, record := range files {
+		status, err := FileStatus(projectPath, relPath, record)
+		if err != nil {
+			return nil, nil, err
+		}
+		switch status {
+		case FileModified:
+			modified = append(modified, relPath)
+		case FileMissing:
+			missing = append(missing, relPath)
+		}
+	}
+
+	sort.Strings(modified)
+	sort.Strings(missing)
+	return modified, missing, nil
+}
@@ -9,24 +9,30 @@ import (
 	"time"
 
 	"github.com/buildwithhp/gophex/internal/types"
+	"github.com/buildwithhp/gophex/internal/utils"
 )
 
 // ProjectMetadata represents the complete metadata structure for a Gophex project
 type ProjectMetadata struct {
-	Project    ProjectInfo             `json:"project"`
-	Hierarchy  map[string]interface{}  `json:"hierarchy"`
-	Database   DatabaseMetadata        `json:"database"`
-	Redis      RedisMetadata           `json:"redis"`
-	Activities map[string]ActivityInfo `json:"activities"`
-	Features   map[string]bool         `json:"features"`
-	Endpoints  []EndpointInfo          `json:"endpoints,omitempty"`
-	Commands   []CommandInfo           `json:"commands,omitempty"`
+	SchemaVersion int                      `json:"schema_version"`
+	Project       ProjectInfo              `json:"project"`
+	Hierarchy     map[string]interface{}   `json:"hierarchy"`
+	Database      DatabaseMetadata         `json:"database"`
+	Redis         RedisMetadata            `json:"redis"`
+	Activities    map[string]ActivityInfo  `json:"activities"`
+	Features      map[string]bool          `json:"features"`
+	Endpoints     []EndpointInfo           `json:"endpoints,omitempty"`
+	Entities      []EntityInfo             `json:"entities,omitempty"`
+	Files         map[string]FileRecord    `json:"files,omitempty"`
+	Commands      []CommandInfo            `json:"commands,omitempty"`
+	Hooks         map[string][]HookCommand `json:"hooks,omitempty"` // keyed by hook event
 }
 
 type ProjectInfo struct {
 	Name          string `json:"name"`
 	Type          string `json:"type"`
 	Version       string `json:"version"`
+	ModulePath    string `json:"module_path,omitempty"` // go.mod module the generated imports are rooted at
 	GophexVersion string `json:"gophex_version"`
 	GeneratedAt   string `json:"generated_at"`
 	LastUpdated   string `json:"last_updated"`
@@ -55,10 +61,42 @@ type ActivityInfo struct {
 }
 
 type EndpointInfo struct {
-	Method      string `json:"method"`
-	Path        string `json:"path"`
-	Description string `json:"description"`
-	Protected   bool   `json:"protected"`
+	Method         string         `json:"method"`
+	Path           string         `json:"path"`
+	Description    string         `json:"description"`
+	Protected      bool           `json:"protected"`
+	Auth           string         `json:"auth,omitempty"`
+	RequestSchema  string         `json:"request_schema,omitempty"`
+	ResponseSchema string         `json:"response_schema,omitempty"`
+	Tags           []string       `json:"tags,omitempty"`
+	RateLimit      *RateLimitInfo `json:"rate_limit,omitempty"`
+}
+
+// RateLimitInfo describes the rate limit applied to an endpoint. Requests is the production
+// limit; Profiles lists the limit of every environment profile the project defines.
+type RateLimitInfo struct {
+	Requests int            `json:"requests"`
+	Window   string         `json:"window"`
+	Profiles map[string]int `json:"profiles,omitempty"`
+}
+
+// EntityInfo describes a domain entity generated by the CRUD wizard
+type EntityInfo struct {
+	Name         string        `json:"name"`
+	PluralName   string        `json:"plural_name,omitempty"`
+	UpdateMethod string        `json:"update_method,omitempty"`
+	Fields       []EntityField `json:"fields,omitempty"`
+	Layers       []string      `json:"layers,omitempty"` // empty means every layer was generated
+	GeneratedAt  string        `json:"generated_at,omitempty"`
+}
+
+// EntityField describes a single field of a generated entity
+type EntityField struct {
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	JSON     string `json:"json,omitempty"`
+	Required bool   `json:"required"`
+	Unique   bool   `json:"unique"`
 }
 
 type CommandInfo struct {
@@ -86,6 +124,7 @@ func (mg *MetadataGenerator) GenerateMetadata(projectName string, dbConfig *type
 	now := time.Now().Format(time.RFC3339)
 
 	metadata := &ProjectMetadata{
+		SchemaVersion: utils.CurrentSchemaVersion,
 		Project: ProjectInfo{
 			Name:          projectName,
 			Type:          mg.projectType,
@@ -98,6 +137,11 @@ func (mg *MetadataGenerator) GenerateMetadata(projectName string, dbConfig *type
 		Activities: mg.generateDefaultActivities(now),
 	}
 
+	// Record the module path so later generators can detect a moved or renamed project
+	if modulePath, err := utils.ReadModulePath(mg.projectPath); err == nil {
+		metadata.Project.ModulePath = modulePath
+	}
+
 	// Scan project structure
 	hierarchy, err := mg.scanProjectHierarchy()
 	if err != nil {
@@ -389,22 +433,24 @@ func (mg *MetadataGenerator) scanFeatures() map[string]bool {
 
 	// Check for common features based on file existence
 	featureFiles := map[string]string{
-		"authentication":     "internal/api/handlers/auth.go",
-		"user_management":    "internal/api/handlers/users.go",
-		"post_management":    "internal/api/handlers/posts.go",
-		"health_checks":      "internal/api/handlers/health.go",
-		"cors_enabled":       "internal/api/middleware/cors.go",
-		"rate_limiting":      "internal/api/middleware/ratelimit.go",
-		"request_logging":    "internal/api/middleware/logging.go",
-		"input_validation":   "internal/pkg/validator/validator.go",
-		"structured_logging": "internal/pkg/logger/logger.go",
-		"clean_architecture": "internal/domain",
-		"graceful_shutdown":  "cmd",
-		"web_server":         "web",
-		"static_files":       "web/static",
-		"html_templates":     "web/templates",
-		"grpc_support":       "internal/handlers",
-		"cobra_framework":    "internal/cmd/root.go",
+		"authentication":        "internal/api/handlers/auth.go",
+		"user_management":       "internal/api/handlers/users.go",
+		"post_management":       "internal/api/handlers/posts.go",
+		"health_checks":         "internal/api/handlers/health.go",
+		"cors_enabled":          "internal/api/middleware/cors.go",
+		"rate_limiting":         "internal/api/middleware/ratelimit.go",
+		"request_logging":       "internal/api/middleware/logging.go",
+		"input_validation":      "internal/pkg/validator/validator.go",
+		"structured_logging":    "internal/pkg/logger/logger.go",
+		"clean_architecture":    "internal/domain",
+		"graceful_shutdown":     "cmd",
+		"web_server":            "web",
+		"static_files":          "web/static",
+		"html_templates":        "web/templates",
+		"grpc_support":          "internal/handlers",
+		"cobra_framework":       "internal/cmd/root.go",
+		"opentelemetry_tracing": "internal/pkg/tracing/tracing.go",
+		"log_correlation":       "internal/api/middleware/tracing.go",
 	}
 
 	for feature, path := range featureFiles {
@@ -417,9 +463,12 @@ func (mg *MetadataGenerator) scanFeatures() map[string]bool {
 	return features
 }
 
-// scanAPIEndpoints scans for API endpoints in handler files
+// scanAPIEndpoints scans the routes file for API endpoints, falling back to the default endpoint set
 func (mg *MetadataGenerator) scanAPIEndpoints() ([]EndpointInfo, error) {
-	var endpoints []EndpointInfo
+	endpoints, err := ScanAPIEndpoints(mg.projectPath)
+	if err != nil || len(endpoints) > 0 {
+		return endpoints, err
+	}
 
 	// Default endpoints that are typically generated
 	defaultEndpoints := []EndpointInfo{
@@ -458,9 +507,11 @@ func (mg *MetadataGenerator) scanAPIEndpoints() ([]EndpointInfo, error) {
 		)
 	}
 
+	for i := range defaultEndpoints {
+		enrichEndpoint(&defaultEndpoints[i], handlerDoc{}, nil)
+	}
 	endpoints = append(endpoints, defaultEndpoints...)
 
-	// TODO: In the future, we could parse the actual route files to extract custom endpoints
 	return endpoints, nil
 }
 
@@ -540,77 +591,33 @@ func UpdateDatabaseStatus(projectPath string, migrationsExecuted, schemaInitiali
 	return SaveMetadata(projectPath, metadata)
 }
 
-// LoadMetadata loads metadata from gophex.md file
-func LoadMetadata(projectPath string) (*ProjectMetadata, error) {
-	filePath := filepath.Join(projectPath, "gophex.md")
-	content, err := os.ReadFile(filePath)
+// SetModulePath records the module path the project's imports are rooted at
+func SetModulePath(projectPath, modulePath string) error {
+	metadata, err := LoadMetadata(projectPath)
 	if err != nil {
-		return nil, fmt.Errorf("failed to read metadata file: %w", err)
-	}
-
-	// Extract JSON from markdown using string operations
-	contentStr := string(content)
-
-	// Try multiple variations of markers to handle different formats
-	startMarkers := []string{
-		"```json\n",
-		"```json\r\n",
-		"``` json\n",
-		"``` json\r\n",
-	}
-
-	endMarkers := []string{
-		"\n```",
-		"\r\n```",
-		"\n```\n",
-		"\r\n```\r\n",
+		return err
 	}
 
-	var jsonContent string
-	var found bool
-
-	for _, startMarker := range startMarkers {
-		startIdx := strings.Index(contentStr, startMarker)
-		if startIdx == -1 {
-			continue
-		}
-
-		jsonStart := startIdx + len(startMarker)
-
-		for _, endMarker := range endMarkers {
-			endIdx := strings.Index(contentStr[jsonStart:], endMarker)
-			if endIdx != -1 {
-				jsonContent = contentStr[jsonStart : jsonStart+endIdx]
-				found = true
-				break
-			}
-		}
+	metadata.Project.ModulePath = modulePath
+	metadata.Project.LastUpdated = time.Now().Format(time.RFC3339)
 
-		if found {
-			break
-		}
-	}
+	return SaveMetadata(projectPath, metadata)
+}
 
-	if !found {
-		preview := contentStr
-		if len(preview) > 200 {
-			preview = preview[:200]
-		}
-		return nil, fmt.Errorf("no JSON markers found in metadata file. File content preview: %q", preview)
-	}
+// LoadMetadata loads metadata from gophex.md file
+func LoadMetadata(projectPath string) (*ProjectMetadata, error) {
 	var metadata ProjectMetadata
-	err = json.Unmarshal([]byte(jsonContent), &metadata)
-	if err != nil {
-		return nil, fmt.Errorf("failed to unmarshal metadata: %w", err)
+	if err := utils.LoadMetadataInto(projectPath, &metadata); err != nil {
+		return nil, err
 	}
 
 	return &metadata, nil
 }
 
-// SaveMetadata saves metadata to gophex.md file
+// SaveMetadata saves metadata to gophex.md file, preserving fields this model does not know about
 func SaveMetadata(projectPath string, metadata *ProjectMetadata) error {
-	mg := NewMetadataGenerator(projectPath, metadata.Project.Type)
-	return mg.WriteMetadataFile(metadata)
+	metadata.SchemaVersion = utils.CurrentSchemaVersion
+	return utils.MergeMetadata(projectPath, metadata)
 }
 
 // IsActivityCompleted checks if an activity has been completed
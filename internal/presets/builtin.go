@@ -0,0 +1,161 @@
+package presets
+
+// timestamps are the audit fields every built-in preset ends with
+var timestamps = []Field{
+	{Name: "CreatedAt", Type: "time.Time", Description: "Creation timestamp"},
+	{Name: "UpdatedAt", Type: "time.Time", Description: "Last update timestamp"},
+}
+
+// Timestamps returns the audit fields used for entities without a preset
+func Timestamps() []Field {
+	return append([]Field{}, timestamps...)
+}
+
+// withTimestamps appends the audit fields to fields
+func withTimestamps(fields ...Field) []Field {
+	return append(fields, timestamps...)
+}
+
+// builtin is the preset library shipped with Gophex, in the order the wizard lists it
+var builtin = []Preset{
+	{
+		Name:    "user",
+		Summary: "User management (name, email, password)",
+		Fields: []Field{
+			{Name: "Name", Type: "string", Required: true, Description: "User's full name"},
+			{Name: "Email", Type: "string", Required: true, Unique: true, Description: "User's email address"},
+			{Name: "Password", Type: "string", Required: true, Description: "User's password (will be hashed)"},
+			{Name: "CreatedAt", Type: "time.Time", Description: "Account creation timestamp"},
+			{Name: "UpdatedAt", Type: "time.Time", Description: "Last update timestamp"},
+		},
+		Relationships: []Relationship{
+			{Kind: HasMany, Entity: "post", Field: "AuthorID"},
+			{Kind: HasMany, Entity: "order", Field: "UserID"},
+		},
+	},
+	{
+		Name:    "post",
+		Summary: "Blog posts or articles (title, content, author)",
+		Fields: withTimestamps(
+			Field{Name: "Title", Type: "string", Required: true, Description: "Post title"},
+			Field{Name: "Content", Type: "string", Required: true, Description: "Post content"},
+			Field{Name: "AuthorID", Type: "int64", Required: true, Description: "Author's user ID"},
+			Field{Name: "Published", Type: "bool", Description: "Publication status"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "user", Field: "AuthorID"},
+			{Kind: HasMany, Entity: "comment", Field: "PostID"},
+		},
+	},
+	{
+		Name:    "product",
+		Summary: "E-commerce products (name, price, description)",
+		Fields: withTimestamps(
+			Field{Name: "Name", Type: "string", Required: true, Description: "Product name"},
+			Field{Name: "Description", Type: "string", Description: "Product description"},
+			Field{Name: "Price", Type: "float64", Required: true, Description: "Product price"},
+			Field{Name: "SKU", Type: "string", Unique: true, Description: "Stock keeping unit"},
+			Field{Name: "InStock", Type: "bool", Description: "Availability status"},
+		),
+	},
+	{
+		Name:    "task",
+		Summary: "Todo or task management (title, description, status)",
+		Fields: withTimestamps(
+			Field{Name: "Title", Type: "string", Required: true, Description: "Task title"},
+			Field{Name: "Description", Type: "string", Description: "Task description"},
+			Field{Name: "Status", Type: "string", Required: true, Description: "Task status (pending, in_progress, completed)"},
+			Field{Name: "Priority", Type: "string", Description: "Task priority (low, medium, high)"},
+			Field{Name: "DueDate", Type: "time.Time", Description: "Task due date"},
+		),
+	},
+	{
+		Name:    "order",
+		Summary: "Customer orders (customer, status, total)",
+		Fields: withTimestamps(
+			Field{Name: "UserID", Type: "int64", Required: true, Description: "Ordering customer's user ID"},
+			Field{Name: "Status", Type: "string", Required: true, Description: "Order status (pending, paid, shipped, cancelled)"},
+			Field{Name: "Total", Type: "float64", Required: true, Description: "Order total"},
+			Field{Name: "Currency", Type: "string", Required: true, Description: "ISO 4217 currency code"},
+			Field{Name: "PlacedAt", Type: "time.Time", Description: "When the order was placed"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "user", Field: "UserID"},
+			{Kind: HasMany, Entity: "invoice", Field: "OrderID"},
+		},
+	},
+	{
+		Name:    "invoice",
+		Summary: "Billing invoices (number, amount, due date)",
+		Fields: withTimestamps(
+			Field{Name: "OrderID", Type: "int64", Required: true, Description: "Invoiced order ID"},
+			Field{Name: "Number", Type: "string", Required: true, Unique: true, Description: "Invoice number"},
+			Field{Name: "Amount", Type: "float64", Required: true, Description: "Amount due"},
+			Field{Name: "Status", Type: "string", Required: true, Description: "Invoice status (draft, issued, paid, void)"},
+			Field{Name: "IssuedAt", Type: "time.Time", Description: "Issue date"},
+			Field{Name: "DueDate", Type: "time.Time", Description: "Payment due date"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "order", Field: "OrderID"},
+		},
+	},
+	{
+		Name:    "comment",
+		Summary: "Comments on posts (post, author, body)",
+		Fields: withTimestamps(
+			Field{Name: "PostID", Type: "int64", Required: true, Description: "Commented post ID"},
+			Field{Name: "AuthorID", Type: "int64", Required: true, Description: "Author's user ID"},
+			Field{Name: "Body", Type: "string", Required: true, Description: "Comment text"},
+			Field{Name: "Approved", Type: "bool", Description: "Moderation status"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "post", Field: "PostID"},
+			{Kind: BelongsTo, Entity: "user", Field: "AuthorID"},
+		},
+	},
+	{
+		Name:    "organization",
+		Summary: "Teams or companies (name, slug, owner)",
+		Fields: withTimestamps(
+			Field{Name: "Name", Type: "string", Required: true, Description: "Organization name"},
+			Field{Name: "Slug", Type: "string", Required: true, Unique: true, Description: "URL-friendly identifier"},
+			Field{Name: "Website", Type: "string", Description: "Website URL"},
+			Field{Name: "OwnerID", Type: "int64", Required: true, Description: "Owner's user ID"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "user", Field: "OwnerID"},
+			{Kind: HasMany, Entity: "address", Field: "OrganizationID"},
+		},
+	},
+	{
+		Name:    "address",
+		Summary: "Postal addresses (street, city, country)",
+		Fields: withTimestamps(
+			Field{Name: "OrganizationID", Type: "int64", Required: true, Description: "Owning organization ID"},
+			Field{Name: "Line1", Type: "string", Required: true, Description: "Street address"},
+			Field{Name: "Line2", Type: "string", Description: "Apartment, suite or unit"},
+			Field{Name: "City", Type: "string", Required: true, Description: "City"},
+			Field{Name: "Region", Type: "string", Description: "State, province or region"},
+			Field{Name: "PostalCode", Type: "string", Required: true, Description: "Postal or ZIP code"},
+			Field{Name: "Country", Type: "string", Required: true, Description: "ISO 3166 country code"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "organization", Field: "OrganizationID"},
+		},
+	},
+	{
+		Name:    "file",
+		Summary: "Uploaded files (name, content type, size)",
+		Fields: withTimestamps(
+			Field{Name: "Name", Type: "string", Required: true, Description: "Original file name"},
+			Field{Name: "ContentType", Type: "string", Required: true, Description: "MIME type"},
+			Field{Name: "Size", Type: "int64", Required: true, Description: "Size in bytes"},
+			Field{Name: "StoragePath", Type: "string", Required: true, Unique: true, Description: "Location in the storage backend"},
+			Field{Name: "Checksum", Type: "string", Description: "SHA-256 of the contents"},
+			Field{Name: "UploadedBy", Type: "int64", Required: true, Description: "Uploader's user ID"},
+		),
+		Relationships: []Relationship{
+			{Kind: BelongsTo, Entity: "user", Field: "UploadedBy"},
+		},
+	},
+}
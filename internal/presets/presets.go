@@ -0,0 +1,261 @@
+// Package presets is the library of entity presets offered by the CRUD wizard:
+// the built-in entities plus any template packs found in the Gophex home
+// directory or the project's .gophex directory.
+package presets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/buildwithhp/gophex/internal/registry"
+)
+
+// BuiltinSource is the Source of the presets shipped with Gophex
+const BuiltinSource = "built-in"
+
+// Relationship kinds
+const (
+	BelongsTo = "belongs_to" // Field on this entity holds the ID of Entity
+	HasMany   = "has_many"   // Field on Entity holds the ID of this entity
+)
+
+// Field is a preset entity field
+type Field struct {
+	Name        string `json:"name"`
+	Type        string `json:"type"`
+	JSON        string `json:"json,omitempty"` // JSON and DB column name; defaults to snake_case of Name
+	Required    bool   `json:"required,omitempty"`
+	Unique      bool   `json:"unique,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Relationship links a preset to another entity through a foreign key field
+type Relationship struct {
+	Kind   string `json:"kind"`
+	Entity string `json:"entity"`
+	Field  string `json:"field"`
+}
+
+// Preset is a ready-made entity definition for the CRUD wizard
+type Preset struct {
+	Name          string         `json:"name"`
+	Summary       string         `json:"summary"`
+	Fields        []Field        `json:"fields"`
+	Relationships []Relationship `json:"relationships,omitempty"`
+	Source        string         `json:"-"` // BuiltinSource or the pack that defined the preset
+}
+
+// Pack is a template pack file: a named collection of presets
+type Pack struct {
+	Name    string   `json:"name"`
+	Presets []Preset `json:"presets"`
+}
+
+// Library is an ordered set of presets, unique by name
+type Library struct {
+	presets []Preset
+}
+
+// fieldTypes are the Go types the CRUD generator supports
+var fieldTypes = map[string]bool{
+	"string":    true,
+	"int":       true,
+	"int64":     true,
+	"float64":   true,
+	"bool":      true,
+	"time.Time": true,
+	"[]string":  true,
+}
+
+var (
+	entityNamePattern = regexp.MustCompile(`^[a-z][a-z0-9]*$`)
+	fieldNamePattern  = regexp.MustCompile(`^[a-zA-Z][a-zA-Z0-9]*$`)
+)
+
+// Builtin returns a library holding only the presets shipped with Gophex
+func Builtin() *Library {
+	library := &Library{}
+	for _, preset := range builtin {
+		preset.Source = BuiltinSource
+		library.add(preset)
+	}
+	return library
+}
+
+// Load returns the built-in presets extended by the template packs in the Gophex
+// home directory and then in projectPath/.gophex/presets; a pack preset replaces
+// any earlier preset of the same name. Packs that fail to load are skipped and
+// reported in the error, so the returned library is always usable.
+func Load(projectPath string) (*Library, error) {
+	library := Builtin()
+
+	dirs := []string{filepath.Join(registry.Dir(), "presets")}
+	if projectPath != "" {
+		dirs = append(dirs, filepath.Join(projectPath, ".gophex", "presets"))
+	}
+
+	var errs []error
+	for _, dir := range dirs {
+		paths, err := filepath.Glob(filepath.Join(dir, "*.json"))
+		if err != nil {
+			errs = append(errs, fmt.Errorf("failed to list template packs in %s: %w", dir, err))
+			continue
+		}
+		sort.Strings(paths)
+
+		for _, path := range paths {
+			pack, err := LoadPack(path)
+			if err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			for _, preset := range pack.Presets {
+				library.add(preset)
+			}
+		}
+	}
+
+	return library, errors.Join(errs...)
+}
+
+// LoadPack reads and validates a template pack file
+func LoadPack(path string) (Pack, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Pack{}, fmt.Errorf("failed to read template pack %s: %w", path, err)
+	}
+
+	var pack Pack
+	if err := json.Unmarshal(data, &pack); err != nil {
+		return Pack{}, fmt.Errorf("failed to parse template pack %s: %w", path, err)
+	}
+
+	if pack.Name == "" {
+		pack.Name = strings.TrimSuffix(filepath.Base(path), filepath.Ext(path))
+	}
+
+	seen := make(map[string]bool)
+	for i := range pack.Presets {
+		if err := pack.Presets[i].Validate(); err != nil {
+			return Pack{}, fmt.Errorf("invalid template pack %s: %w", path, err)
+		}
+		if seen[pack.Presets[i].Name] {
+			return Pack{}, fmt.Errorf("invalid template pack %s: preset %q is defined twice", path, pack.Presets[i].Name)
+		}
+		seen[pack.Presets[i].Name] = true
+		pack.Presets[i].Source = pack.Name
+	}
+
+	return pack, nil
+}
+
+// All returns the presets in display order
+func (l *Library) All() []Preset {
+	return append([]Preset{}, l.presets...)
+}
+
+// Lookup returns the preset with the given entity name
+func (l *Library) Lookup(name string) (Preset, bool) {
+	for _, preset := range l.presets {
+		if preset.Name == name {
+			return preset, true
+		}
+	}
+	return Preset{}, false
+}
+
+// add appends preset, or replaces the preset of the same name in place
+func (l *Library) add(preset Preset) {
+	for i := range l.presets {
+		if l.presets[i].Name == preset.Name {
+			l.presets[i] = preset
+			return
+		}
+	}
+	l.presets = append(l.presets, preset)
+}
+
+// Validate checks that the preset can be generated by the CRUD generator
+func (p Preset) Validate() error {
+	if !entityNamePattern.MatchString(p.Name) {
+		return fmt.Errorf("preset %q: name must be lowercase letters and digits, starting with a letter", p.Name)
+	}
+	if len(p.Fields) == 0 {
+		return fmt.Errorf("preset %q: at least one field is required", p.Name)
+	}
+
+	fields := make(map[string]bool)
+	for _, field := range p.Fields {
+		if !fieldNamePattern.MatchString(field.Name) {
+			return fmt.Errorf("preset %q: invalid field name %q", p.Name, field.Name)
+		}
+		if fields[strings.ToLower(field.Name)] {
+			return fmt.Errorf("preset %q: field %q is defined twice", p.Name, field.Name)
+		}
+		if !fieldTypes[field.Type] {
+			return fmt.Errorf("preset %q: field %q has unsupported type %q", p.Name, field.Name, field.Type)
+		}
+		fields[strings.ToLower(field.Name)] = true
+	}
+
+	for _, rel := range p.Relationships {
+		if !entityNamePattern.MatchString(rel.Entity) {
+			return fmt.Errorf("preset %q: relationship has invalid entity %q", p.Name, rel.Entity)
+		}
+		if !fieldNamePattern.MatchString(rel.Field) {
+			return fmt.Errorf("preset %q: relationship with %s has invalid field %q", p.Name, rel.Entity, rel.Field)
+		}
+		switch rel.Kind {
+		case BelongsTo:
+			if !fields[strings.ToLower(rel.Field)] {
+				return fmt.Errorf("preset %q: belongs_to %s uses missing field %q", p.Name, rel.Entity, rel.Field)
+			}
+		case HasMany:
+		default:
+			return fmt.Errorf("preset %q: unknown relationship kind %q (use %s or %s)", p.Name, rel.Kind, BelongsTo, HasMany)
+		}
+	}
+
+	return nil
+}
+
+// Tag returns the JSON and database column name of the field
+func (f Field) Tag() string {
+	if f.JSON != "" {
+		return f.JSON
+	}
+	return SnakeCase(f.Name)
+}
+
+// String describes the relationship, e.g. "belongs to user (UserID)"
+func (r Relationship) String() string {
+	if r.Kind == HasMany {
+		return fmt.Sprintf("has many %s (%s)", r.Entity, r.Field)
+	}
+	return fmt.Sprintf("belongs to %s (%s)", r.Entity, r.Field)
+}
+
+// SnakeCase converts a Go field name to snake_case, keeping initialisms together
+// (AuthorID -> author_id, SKU -> sku, StorageURL -> storage_url)
+func SnakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if i > 0 && unicode.IsUpper(r) {
+			prev := runes[i-1]
+			nextLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower) {
+				b.WriteByte('_')
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
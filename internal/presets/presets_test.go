@@ -0,0 +1,177 @@
+package presets
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuiltinPresetsAreValid(t *testing.T) {
+	library := Builtin()
+
+	for _, preset := range library.All() {
+		if err := preset.Validate(); err != nil {
+			t.Errorf("Built-in preset %s is invalid: %v", preset.Name, err)
+		}
+		if preset.Source != BuiltinSource {
+			t.Errorf("Expected source %q for %s, got %q", BuiltinSource, preset.Name, preset.Source)
+		}
+	}
+
+	for _, name := range []string{"user", "post", "product", "task", "order", "invoice", "comment", "organization", "address", "file"} {
+		if _, ok := library.Lookup(name); !ok {
+			t.Errorf("Expected built-in preset %s", name)
+		}
+	}
+}
+
+func TestSnakeCase(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"Name", "name"},
+		{"CreatedAt", "created_at"},
+		{"AuthorID", "author_id"},
+		{"SKU", "sku"},
+		{"InStock", "in_stock"},
+		{"StorageURL", "storage_url"},
+		{"HTTPStatus", "http_status"},
+		{"Line1", "line1"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.input, func(t *testing.T) {
+			if result := SnakeCase(test.input); result != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	valid := Preset{
+		Name:          "subscription",
+		Fields:        []Field{{Name: "UserID", Type: "int64"}, {Name: "Plan", Type: "string"}},
+		Relationships: []Relationship{{Kind: BelongsTo, Entity: "user", Field: "UserID"}},
+	}
+
+	tests := []struct {
+		name    string
+		mutate  func(p *Preset)
+		wantErr string
+	}{
+		{"valid", func(p *Preset) {}, ""},
+		{"uppercase name", func(p *Preset) { p.Name = "Subscription" }, "name must be lowercase"},
+		{"no fields", func(p *Preset) { p.Fields = nil }, "at least one field"},
+		{"bad field name", func(p *Preset) { p.Fields[1].Name = "plan_id" }, "invalid field name"},
+		{"duplicate field", func(p *Preset) { p.Fields[1].Name = "userid" }, "defined twice"},
+		{"unsupported type", func(p *Preset) { p.Fields[1].Type = "uuid.UUID" }, "unsupported type"},
+		{"missing foreign key", func(p *Preset) { p.Relationships[0].Field = "AccountID" }, "missing field"},
+		{"unknown kind", func(p *Preset) { p.Relationships[0].Kind = "many_to_many" }, "unknown relationship kind"},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			preset := valid
+			preset.Fields = append([]Field{}, valid.Fields...)
+			preset.Relationships = append([]Relationship{}, valid.Relationships...)
+			test.mutate(&preset)
+
+			err := preset.Validate()
+			if test.wantErr == "" {
+				if err != nil {
+					t.Errorf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), test.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", test.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestLoadTemplatePacks(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+	projectDir := t.TempDir()
+
+	writePack(t, filepath.Join(home, "presets", "billing.json"), `{
+  "name": "billing",
+  "presets": [
+    {
+      "name": "subscription",
+      "summary": "Recurring plans",
+      "fields": [
+        {"name": "UserID", "type": "int64", "required": true},
+        {"name": "Plan", "type": "string", "required": true}
+      ],
+      "relationships": [{"kind": "belongs_to", "entity": "user", "field": "UserID"}]
+    }
+  ]
+}`)
+	writePack(t, filepath.Join(projectDir, ".gophex", "presets", "team.json"), `{
+  "presets": [
+    {
+      "name": "user",
+      "summary": "Team members",
+      "fields": [{"name": "Handle", "type": "string", "unique": true, "json": "login"}]
+    }
+  ]
+}`)
+
+	library, err := Load(projectDir)
+	if err != nil {
+		t.Fatalf("Failed to load presets: %v", err)
+	}
+
+	subscription, ok := library.Lookup("subscription")
+	if !ok {
+		t.Fatal("Expected subscription preset from the home template pack")
+	}
+	if subscription.Source != "billing" {
+		t.Errorf("Expected source billing, got %q", subscription.Source)
+	}
+
+	// The project pack overrides the built-in user preset in place
+	all := library.All()
+	if all[0].Name != "user" || all[0].Summary != "Team members" {
+		t.Errorf("Expected overridden user preset first, got %s (%s)", all[0].Name, all[0].Summary)
+	}
+	if all[0].Source != "team" {
+		t.Errorf("Expected pack name to default to the file name, got %q", all[0].Source)
+	}
+	if tag := all[0].Fields[0].Tag(); tag != "login" {
+		t.Errorf("Expected explicit JSON tag login, got %q", tag)
+	}
+	if all[len(all)-1].Name != "subscription" {
+		t.Errorf("Expected new presets to be listed last, got %s", all[len(all)-1].Name)
+	}
+}
+
+func TestLoadSkipsInvalidPacks(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	writePack(t, filepath.Join(home, "presets", "broken.json"), `{"presets": [{"name": "Bad", "fields": []}]}`)
+
+	library, err := Load("")
+	if err == nil || !strings.Contains(err.Error(), "broken.json") {
+		t.Errorf("Expected error naming broken.json, got %v", err)
+	}
+	if len(library.All()) != len(builtin) {
+		t.Errorf("Expected %d built-in presets, got %d", len(builtin), len(library.All()))
+	}
+}
+
+func writePack(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatalf("Failed to create pack directory: %v", err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write pack: %v", err)
+	}
+}
@@ -0,0 +1,237 @@
+// Package registry keeps track of the Gophex projects on this machine so that
+// workspace-wide commands such as "gophex dashboard" can find them.
+package registry
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/buildwithhp/gophex/internal/utils"
+)
+
+// lockTimeout bounds how long an update waits for another gophex process to finish its own
+const lockTimeout = 5 * time.Second
+
+// staleLockAge is the age after which a lock left behind by a crashed gophex process is removed
+const staleLockAge = 30 * time.Second
+
+// Project is a single registered Gophex project
+type Project struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	Path         string `json:"path"`
+	RegisteredAt string `json:"registered_at"`
+	LastOpened   string `json:"last_opened,omitempty"`
+	PID          int    `json:"pid,omitempty"`
+}
+
+// Registry is the on-disk list of registered projects
+type Registry struct {
+	Projects []Project `json:"projects"`
+}
+
+// Dir returns the Gophex home directory ($GOPHEX_HOME, defaulting to ~/.gophex)
+func Dir() string {
+	if home := utils.GetEnvWithDefault("GOPHEX_HOME", ""); home != "" {
+		return home
+	}
+
+	userHome, err := os.UserHomeDir()
+	if err != nil {
+		return ".gophex"
+	}
+	return filepath.Join(userHome, ".gophex")
+}
+
+// FilePath returns the location of the registry file
+func FilePath() string {
+	return filepath.Join(Dir(), "projects.json")
+}
+
+// Load reads the registry, returning an empty registry when none exists yet
+func Load() (*Registry, error) {
+	data, err := os.ReadFile(FilePath())
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &Registry{}, nil
+		}
+		return nil, fmt.Errorf("failed to read project registry: %w", err)
+	}
+
+	var registry Registry
+	if err := json.Unmarshal(data, &registry); err != nil {
+		return nil, fmt.Errorf("failed to parse project registry: %w", err)
+	}
+
+	return &registry, nil
+}
+
+// Save writes the registry to disk. It writes a temporary file and renames it over the
+// registry, so readers never see a partly written file.
+func (r *Registry) Save() error {
+	if err := os.MkdirAll(Dir(), 0755); err != nil {
+		return fmt.Errorf("failed to create gophex home directory: %w", err)
+	}
+
+	sort.Slice(r.Projects, func(i, j int) bool {
+		return r.Projects[i].Path < r.Projects[j].Path
+	})
+
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal project registry: %w", err)
+	}
+
+	temp, err := os.CreateTemp(Dir(), "projects-*.json")
+	if err != nil {
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	defer os.Remove(temp.Name())
+
+	if _, err := temp.Write(data); err != nil {
+		temp.Close()
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	if err := temp.Close(); err != nil {
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+	if err := os.Rename(temp.Name(), FilePath()); err != nil {
+		return fmt.Errorf("failed to write project registry: %w", err)
+	}
+
+	return nil
+}
+
+// update loads the registry, applies change and saves the result while holding the registry
+// lock, so gophex processes updating it at the same time do not drop each other's changes.
+// Nothing is saved when change reports no modification.
+func update(change func(*Registry) bool) error {
+	unlock, err := lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+
+	registry, err := Load()
+	if err != nil {
+		return err
+	}
+	if !change(registry) {
+		return nil
+	}
+	return registry.Save()
+}
+
+// lock takes the registry lock file, waiting up to lockTimeout for another process to release it
+func lock() (func(), error) {
+	if err := os.MkdirAll(Dir(), 0755); err != nil {
+		return nil, fmt.Errorf("failed to create gophex home directory: %w", err)
+	}
+
+	path := FilePath() + ".lock"
+	deadline := time.Now().Add(lockTimeout)
+	for {
+		file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0644)
+		if err == nil {
+			file.Close()
+			return func() { os.Remove(path) }, nil
+		}
+		if !os.IsExist(err) {
+			return nil, fmt.Errorf("failed to lock project registry: %w", err)
+		}
+
+		if info, err := os.Stat(path); err == nil && time.Since(info.ModTime()) > staleLockAge {
+			os.Remove(path)
+			continue
+		}
+		if time.Now().After(deadline) {
+			return nil, fmt.Errorf("project registry is locked by another gophex process; remove %s if none is running", path)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+// Find returns the registered project at path, if any
+func (r *Registry) Find(path string) *Project {
+	for i := range r.Projects {
+		if r.Projects[i].Path == path {
+			return &r.Projects[i]
+		}
+	}
+	return nil
+}
+
+// Register adds a project to the registry or refreshes its name, type and last-opened time
+func Register(name, projectType, path string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	return update(func(registry *Registry) bool {
+		now := time.Now().Format(time.RFC3339)
+		if project := registry.Find(absPath); project != nil {
+			project.Name = name
+			project.Type = projectType
+			project.LastOpened = now
+		} else {
+			registry.Projects = append(registry.Projects, Project{
+				Name:         name,
+				Type:         projectType,
+				Path:         absPath,
+				RegisteredAt: now,
+				LastOpened:   now,
+			})
+		}
+		return true
+	})
+}
+
+// Unregister removes the project at path from the registry
+func Unregister(path string) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	return update(func(registry *Registry) bool {
+		for i, project := range registry.Projects {
+			if project.Path == absPath {
+				registry.Projects = append(registry.Projects[:i], registry.Projects[i+1:]...)
+				return true
+			}
+		}
+		return false
+	})
+}
+
+// SetPID records the PID of an application started for a registered project (0 clears it).
+// Unregistered paths are ignored.
+func SetPID(path string, pid int) error {
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return fmt.Errorf("failed to resolve project path: %w", err)
+	}
+
+	return update(func(registry *Registry) bool {
+		project := registry.Find(absPath)
+		if project == nil || project.PID == pid {
+			return false
+		}
+		project.PID = pid
+		return true
+	})
+}
+
+// List returns all registered projects
+func List() ([]Project, error) {
+	registry, err := Load()
+	if err != nil {
+		return nil, err
+	}
+	return registry.Projects, nil
+}
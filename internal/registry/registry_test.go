@@ -0,0 +1,127 @@
+package registry
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRegisterAndUnregister(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	projectDir := filepath.Join(home, "myapi")
+	if err := os.MkdirAll(projectDir, 0755); err != nil {
+		t.Fatalf("Failed to create project directory: %v", err)
+	}
+
+	if err := Register("myapi", "api", projectDir); err != nil {
+		t.Fatalf("Failed to register project: %v", err)
+	}
+	// Registering again must not create a duplicate entry
+	if err := Register("myapi", "api", projectDir); err != nil {
+		t.Fatalf("Failed to re-register project: %v", err)
+	}
+
+	projects, err := List()
+	if err != nil {
+		t.Fatalf("Failed to list projects: %v", err)
+	}
+	if len(projects) != 1 {
+		t.Fatalf("Expected 1 registered project, got %d", len(projects))
+	}
+	if projects[0].Path != projectDir || projects[0].Type != "api" {
+		t.Errorf("Unexpected project entry: %+v", projects[0])
+	}
+
+	if err := SetPID(projectDir, 4242); err != nil {
+		t.Fatalf("Failed to set PID: %v", err)
+	}
+	projects, _ = List()
+	if projects[0].PID != 4242 {
+		t.Errorf("Expected PID 4242, got %d", projects[0].PID)
+	}
+
+	if err := Unregister(projectDir); err != nil {
+		t.Fatalf("Failed to unregister project: %v", err)
+	}
+	projects, _ = List()
+	if len(projects) != 0 {
+		t.Errorf("Expected empty registry, got %d projects", len(projects))
+	}
+}
+
+func TestSetPIDIgnoresUnregisteredProjects(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	if err := SetPID(filepath.Join(home, "unknown"), 1234); err != nil {
+		t.Fatalf("Expected no error for unregistered project, got: %v", err)
+	}
+
+	if _, err := os.Stat(FilePath()); !os.IsNotExist(err) {
+		t.Error("Registry file should not be created for unregistered projects")
+	}
+}
+
+func TestConcurrentUpdatesKeepEveryProject(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	const count = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, count)
+	for i := 0; i < count; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			name := fmt.Sprintf("project%d", i)
+			errs <- Register(name, "api", filepath.Join(home, name))
+		}(i)
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		if err != nil {
+			t.Fatalf("Failed to register project: %v", err)
+		}
+	}
+
+	projects, err := List()
+	if err != nil {
+		t.Fatalf("Failed to list projects: %v", err)
+	}
+	if len(projects) != count {
+		t.Errorf("Expected %d registered projects, got %d", count, len(projects))
+	}
+
+	leftovers, _ := filepath.Glob(filepath.Join(home, "projects*"))
+	if len(leftovers) != 1 {
+		t.Errorf("Expected only projects.json in the gophex home, got %v", leftovers)
+	}
+}
+
+func TestStaleLockIsRemoved(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("GOPHEX_HOME", home)
+
+	lockPath := FilePath() + ".lock"
+	if err := os.WriteFile(lockPath, nil, 0644); err != nil {
+		t.Fatalf("Failed to create lock: %v", err)
+	}
+	stale := time.Now().Add(-2 * staleLockAge)
+	if err := os.Chtimes(lockPath, stale, stale); err != nil {
+		t.Fatalf("Failed to age lock: %v", err)
+	}
+
+	if err := Register("myapi", "api", filepath.Join(home, "myapi")); err != nil {
+		t.Fatalf("Expected a stale lock to be taken over, got: %v", err)
+	}
+	if _, err := os.Stat(lockPath); !os.IsNotExist(err) {
+		t.Error("Expected the lock to be released")
+	}
+}
@@ -0,0 +1,147 @@
+// Package failure classifies Gophex errors so callers can react to them: each error kind
+// has its own exit code, and errors can carry a hint that tells the user how to fix them.
+package failure
+
+import (
+	"errors"
+	"fmt"
+	"io"
+)
+
+// Kind is the category of an error
+type Kind int
+
+const (
+	// KindInternal is any error that has not been classified; it exits with status 1
+	KindInternal Kind = iota
+	// KindUser errors come from invalid arguments, answers or project state the user can change
+	KindUser
+	// KindEnvironment errors come from the machine: missing files or directories, permissions,
+	// or services that cannot be reached
+	KindEnvironment
+	// KindTemplate errors come from Gophex's own templates failing to render
+	KindTemplate
+	// KindTool errors come from an external program, such as go, git, docker or migrate,
+	// that is missing or failed
+	KindTool
+)
+
+// TemplateBugHint is shown when a bundled template fails to render, which the user cannot fix
+const TemplateBugHint = "this is a bug in Gophex: please report it at https://github.com/buildwithhp/gophex/issues"
+
+// String returns the kind's name as shown to users and scripts
+func (k Kind) String() string {
+	switch k {
+	case KindUser:
+		return "user"
+	case KindEnvironment:
+		return "environment"
+	case KindTemplate:
+		return "template"
+	case KindTool:
+		return "tool"
+	default:
+		return "internal"
+	}
+}
+
+// ExitCode returns the process exit status for errors of this kind
+func (k Kind) ExitCode() int {
+	switch k {
+	case KindUser:
+		return 2
+	case KindEnvironment:
+		return 3
+	case KindTemplate:
+		return 4
+	case KindTool:
+		return 5
+	default:
+		return 1
+	}
+}
+
+// Error is a classified error with an optional remediation hint
+type Error struct {
+	Kind Kind
+	Hint string
+	Err  error
+}
+
+// Error implements the error interface; the kind and hint are reported separately
+func (e *Error) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New classifies err as kind with a hint, which may be empty. It returns nil for a nil error.
+func New(kind Kind, err error, hint string) error {
+	if err == nil {
+		return nil
+	}
+	return &Error{Kind: kind, Hint: hint, Err: err}
+}
+
+// User classifies err as a user error
+func User(err error, hint string) error {
+	return New(KindUser, err, hint)
+}
+
+// Environment classifies err as an environment error
+func Environment(err error, hint string) error {
+	return New(KindEnvironment, err, hint)
+}
+
+// Template classifies err as a template error
+func Template(err error, hint string) error {
+	return New(KindTemplate, err, hint)
+}
+
+// Tool classifies err as an external tool error
+func Tool(err error, hint string) error {
+	return New(KindTool, err, hint)
+}
+
+// KindOf returns the kind of the outermost classified error in err's chain, or KindInternal
+func KindOf(err error) Kind {
+	var classified *Error
+	if errors.As(err, &classified) {
+		return classified.Kind
+	}
+	return KindInternal
+}
+
+// HintOf returns the first hint found in err's chain, or ""
+func HintOf(err error) string {
+	for err != nil {
+		var classified *Error
+		if !errors.As(err, &classified) {
+			return ""
+		}
+		if classified.Hint != "" {
+			return classified.Hint
+		}
+		err = classified.Err
+	}
+	return ""
+}
+
+// ExitCode returns the exit status for err: 0 for nil, otherwise its kind's exit code
+func ExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	return KindOf(err).ExitCode()
+}
+
+// Report writes err and its remediation hint to w, the way the gophex binaries show a failure
+func Report(w io.Writer, err error) {
+	fmt.Fprintf(w, "Error: %v\n", err)
+	if hint := HintOf(err); hint != "" {
+		fmt.Fprintf(w, "Hint: %s\n", hint)
+	}
+}
@@ -0,0 +1,91 @@
+package failure
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"testing"
+)
+
+func TestExitCode(t *testing.T) {
+	cause := errors.New("boom")
+
+	tests := []struct {
+		name     string
+		err      error
+		kind     Kind
+		expected int
+	}{
+		{"nil", nil, KindInternal, 0},
+		{"unclassified", cause, KindInternal, 1},
+		{"user", User(cause, ""), KindUser, 2},
+		{"environment", Environment(cause, ""), KindEnvironment, 3},
+		{"template", Template(cause, ""), KindTemplate, 4},
+		{"tool", Tool(cause, ""), KindTool, 5},
+		{"wrapped", fmt.Errorf("generation failed: %w", Tool(cause, "")), KindTool, 5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if kind := KindOf(tt.err); kind != tt.kind {
+				t.Errorf("Expected kind %s, got %s", tt.kind, kind)
+			}
+			if code := ExitCode(tt.err); code != tt.expected {
+				t.Errorf("Expected exit code %d, got %d", tt.expected, code)
+			}
+		})
+	}
+}
+
+func TestClassifiedErrorKeepsMessageAndCause(t *testing.T) {
+	cause := errors.New("exit status 1")
+	err := fmt.Errorf("install failed: %w", Tool(cause, "install Go"))
+
+	if err.Error() != "install failed: exit status 1" {
+		t.Errorf("Expected the hint to stay out of the message, got %q", err.Error())
+	}
+	if !errors.Is(err, cause) {
+		t.Error("Expected the cause to be reachable with errors.Is")
+	}
+	if New(KindUser, nil, "hint") != nil {
+		t.Error("Expected nil for a nil error")
+	}
+}
+
+func TestHintOf(t *testing.T) {
+	cause := errors.New("boom")
+
+	tests := []struct {
+		name     string
+		err      error
+		expected string
+	}{
+		{"unclassified", cause, ""},
+		{"no hint", User(cause, ""), ""},
+		{"hint", Environment(cause, "pass --path"), "pass --path"},
+		{"outer hint wins", User(Tool(cause, "inner"), "outer"), "outer"},
+		{"inner hint", fmt.Errorf("wrapped: %w", User(Tool(cause, "inner"), "")), "inner"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if hint := HintOf(tt.err); hint != tt.expected {
+				t.Errorf("Expected hint %q, got %q", tt.expected, hint)
+			}
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	var out bytes.Buffer
+	Report(&out, User(errors.New("no project name"), "pass --name"))
+	if expected := "Error: no project name\nHint: pass --name\n"; out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+
+	out.Reset()
+	Report(&out, errors.New("boom"))
+	if expected := "Error: boom\n"; out.String() != expected {
+		t.Errorf("Expected %q, got %q", expected, out.String())
+	}
+}
@@ -3,9 +3,12 @@ package templates
 import (
 	"embed"
 	"fmt"
+	"go/version"
 	"io/fs"
 	"strings"
 	"text/template"
+
+	"github.com/buildwithhp/gophex/internal/deps"
 )
 
 //go:embed api api-gin api-echo api-gorilla webapp microservice cli
@@ -35,6 +38,36 @@ type RedisConfig struct {
 	Database int
 }
 
+type DockerConfig struct {
+	RuntimeImage string   // distroless, alpine, scratch
+	Platforms    []string // buildx target platforms
+}
+
+// PlatformList returns the platforms in the comma-separated form buildx expects
+func (d DockerConfig) PlatformList() string {
+	return strings.Join(d.Platforms, ",")
+}
+
+type GoConfig struct {
+	Version   string // language version for the go directive, e.g. 1.23
+	Toolchain string // toolchain directive, e.g. go1.24.5; empty to omit
+}
+
+// AtLeast reports whether the project targets release v or newer, for gating
+// templates on standard library features such as the Go 1.22 ServeMux patterns
+func (g GoConfig) AtLeast(v string) bool {
+	return version.Compare("go"+g.Version, "go"+v) >= 0
+}
+
+// ImageVersion returns the golang image tag for the Docker build stage, following
+// the toolchain when one is pinned since it is never older than the go directive
+func (g GoConfig) ImageVersion() string {
+	if g.Toolchain != "" {
+		return strings.TrimPrefix(version.Lang(g.Toolchain), "go")
+	}
+	return strings.TrimPrefix(version.Lang("go"+g.Version), "go")
+}
+
 type TemplateData struct {
 	ProjectName    string
 	Title          string // Alias for ProjectName for template compatibility
@@ -42,14 +75,28 @@ type TemplateData struct {
 	Framework      string // Web framework (gin, echo, gorilla) for API projects
 	DatabaseConfig DatabaseConfig
 	RedisConfig    RedisConfig
+	Go             GoConfig
+	Docker         DockerConfig
+	MigrateInstall string // go install command for the pinned golang-migrate release
+	MinimalDeps    bool   // prefer the standard library over third-party packages; api templates only, since minimal mode has no framework
+	Tracing        bool   // OpenTelemetry tracing, with trace and span IDs in logs and response headers
 	GeneratedAt    string
 	GophexVersion  string
-	Checksums      map[string]string
+}
+
+// ConfigFormat returns the format of the optional config file: JSON via encoding/json
+// in minimal dependency mode, YAML otherwise
+func (d TemplateData) ConfigFormat() string {
+	if d.MinimalDeps {
+		return "json"
+	}
+	return "yaml"
 }
 
 type FileTemplate struct {
 	Path    string
 	Content string
+	Source  string // embedded template the file is rendered from, e.g. api/cmd/api/main.go.tmpl
 }
 
 func GetTemplateFiles(templateType string) ([]FileTemplate, error) {
@@ -82,10 +129,18 @@ func GetTemplateFiles(templateType string) ([]FileTemplate, error) {
 		if relativePath == "env" {
 			relativePath = ".env"
 		}
+		if relativePath == "dockerignore" {
+			relativePath = ".dockerignore"
+		}
+		// go:embed skips dot-directories, so .github is stored as github
+		if strings.HasPrefix(relativePath, "github/") {
+			relativePath = "." + relativePath
+		}
 
 		files = append(files, FileTemplate{
 			Path:    relativePath,
 			Content: string(content),
+			Source:  path,
 		})
 
 		return nil
@@ -98,8 +153,14 @@ func GetTemplateFiles(templateType string) ([]FileTemplate, error) {
 	return files, nil
 }
 
+// templateFuncs are available to every template; require pins a module to its
+// catalog version, e.g. {{require "github.com/gin-gonic/gin"}} in go.mod
+var templateFuncs = template.FuncMap{
+	"require": deps.Require,
+}
+
 func ProcessTemplate(content string, data TemplateData) (string, error) {
-	tmpl, err := template.New("template").Parse(content)
+	tmpl, err := template.New("template").Funcs(templateFuncs).Parse(content)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse template: %w", err)
 	}
@@ -1,6 +1,7 @@
 package templates
 
 import (
+	"strings"
 	"testing"
 )
 
@@ -20,6 +21,21 @@ func TestGetTemplateFiles(t *testing.T) {
 	}
 }
 
+func TestFrameworkTemplatesIgnoreMinimalDeps(t *testing.T) {
+	// The generator rejects frameworks in minimal dependency mode, so only the api set renders it
+	for _, templateType := range []string{"api-gin", "api-echo", "api-gorilla"} {
+		files, err := GetTemplateFiles(templateType)
+		if err != nil {
+			t.Fatalf("Failed to get %s template files: %v", templateType, err)
+		}
+		for _, f := range files {
+			if strings.Contains(f.Content, "MinimalDeps") || strings.Contains(f.Content, "ConfigFormat") {
+				t.Errorf("Expected %s to have no minimal dependency branches", f.Source)
+			}
+		}
+	}
+}
+
 func TestProcessTemplate(t *testing.T) {
 	content := "module {{.ModuleName}}\n\nproject: {{.ProjectName}}"
 	data := TemplateData{
@@ -0,0 +1,34 @@
+// Package testutil holds fixtures shared by the command and metadata tests.
+package testutil
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/metadata"
+)
+
+// WriteProject creates a temporary project holding files, keyed by slash-separated path.
+// A non-nil projectMetadata is saved to gophex.md through metadata.SaveMetadata.
+func WriteProject(t testing.TB, projectMetadata *metadata.ProjectMetadata, files map[string]string) string {
+	t.Helper()
+	projectPath := t.TempDir()
+
+	for name, content := range files {
+		path := filepath.Join(projectPath, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatalf("Failed to create %s: %v", filepath.Dir(path), err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatalf("Failed to write %s: %v", name, err)
+		}
+	}
+
+	if projectMetadata != nil {
+		if err := metadata.SaveMetadata(projectPath, projectMetadata); err != nil {
+			t.Fatalf("Failed to write gophex.md: %v", err)
+		}
+	}
+	return projectPath
+}
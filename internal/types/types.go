@@ -25,3 +25,15 @@ type RedisConfig struct {
 	Password string
 	Database int
 }
+
+// DockerConfig represents container image configuration
+type DockerConfig struct {
+	RuntimeImage string   // distroless, alpine, scratch
+	Platforms    []string // buildx target platforms, e.g. linux/amd64
+}
+
+// GoConfig represents the Go release a generated project targets
+type GoConfig struct {
+	Version   string // language version for the go directive, e.g. 1.23
+	Toolchain string // toolchain directive, e.g. go1.24.5; empty to omit
+}
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"os"
 
 	"github.com/buildwithhp/gophex/internal/app"
 	"github.com/buildwithhp/gophex/internal/cmd"
@@ -21,6 +22,15 @@ func NewCLI(application *app.Application) *CLI {
 
 // Execute runs the CLI application
 func (c *CLI) Execute(ctx context.Context) error {
-	// Use the existing Execute function from the cmd package
-	return cmd.Execute()
+	return c.Run(os.Args[1:])
+}
+
+// Run applies global flags such as --accessible and --defaults, then dispatches the
+// subcommand, falling back to interactive mode without one
+func (c *CLI) Run(args []string) error {
+	args, err := cmd.ParseGlobalFlags(args)
+	if err != nil {
+		return err
+	}
+	return cmd.ExecuteCommand(args)
 }
@@ -0,0 +1,26 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+func TestRunAppliesGlobalFlags(t *testing.T) {
+	c := NewCLI(nil)
+
+	for _, args := range [][]string{
+		{"--accessible", "help"},
+		{"--defaults", "version"},
+		{"--yes", "help"},
+		{"-y", "version"},
+	} {
+		if err := c.Run(args); err != nil {
+			t.Errorf("Expected %v to run, got %v", args, err)
+		}
+	}
+
+	if err := c.Run([]string{"--verbose"}); failure.KindOf(err) != failure.KindUser {
+		t.Errorf("Expected a user error for an unknown command, got %v", err)
+	}
+}
@@ -0,0 +1,177 @@
+package utils
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// legacyGenerationFiles are the key=value generation files written by earlier releases of Gophex.
+// The template rendered "gophex-generated" while tools looked for ".gophex-generated", so both are read.
+var legacyGenerationFiles = []string{".gophex-generated", "gophex-generated"}
+
+// GenerationInfo describes how a project was generated
+type GenerationInfo struct {
+	ProjectName    string
+	ProjectType    string
+	GeneratedAt    string
+	GophexVersion  string
+	DatabaseType   string
+	DatabaseConfig string
+}
+
+// generationDocument is the part of gophex.md that carries generation information
+type generationDocument struct {
+	Project struct {
+		Name          string `json:"name"`
+		Type          string `json:"type"`
+		GophexVersion string `json:"gophex_version"`
+		GeneratedAt   string `json:"generated_at"`
+	} `json:"project"`
+	Database struct {
+		Type       string `json:"type"`
+		ConfigType string `json:"config_type"`
+	} `json:"database"`
+}
+
+// ReadGenerationInfo returns generation information from gophex.md, filling any gaps
+// from a legacy .gophex-generated file. It fails only when neither source exists.
+func ReadGenerationInfo(projectPath string) (*GenerationInfo, error) {
+	info := &GenerationInfo{}
+	found := false
+
+	if HasGophexMetadata(projectPath) {
+		var doc generationDocument
+		if err := LoadMetadataInto(projectPath, &doc); err != nil {
+			return nil, err
+		}
+		info = &GenerationInfo{
+			ProjectName:    doc.Project.Name,
+			ProjectType:    doc.Project.Type,
+			GeneratedAt:    doc.Project.GeneratedAt,
+			GophexVersion:  doc.Project.GophexVersion,
+			DatabaseType:   doc.Database.Type,
+			DatabaseConfig: doc.Database.ConfigType,
+		}
+		found = true
+	}
+
+	legacy, err := readLegacyGenerationFile(projectPath)
+	if err != nil {
+		return nil, err
+	}
+	if legacy != nil {
+		fillGenerationInfo(info, legacy)
+		found = true
+	}
+
+	if !found {
+		return nil, fmt.Errorf("no generation metadata found in %s", projectPath)
+	}
+
+	return info, nil
+}
+
+// ConsolidateGenerationFile merges a legacy .gophex-generated file into gophex.md so that
+// gophex.md is the single source of generation information. The legacy file is left in
+// place for scripts generated by older releases. It reports whether gophex.md was updated.
+func ConsolidateGenerationFile(projectPath string) (bool, error) {
+	if !HasGophexMetadata(projectPath) {
+		return false, nil
+	}
+
+	legacy, err := readLegacyGenerationFile(projectPath)
+	if err != nil || legacy == nil {
+		return false, err
+	}
+
+	var doc generationDocument
+	if err := LoadMetadataInto(projectPath, &doc); err != nil {
+		return false, err
+	}
+
+	project := make(map[string]interface{})
+	database := make(map[string]interface{})
+	setIfMissing := func(section map[string]interface{}, key, current, value string) {
+		if current == "" && value != "" {
+			section[key] = value
+		}
+	}
+	setIfMissing(project, "generated_at", doc.Project.GeneratedAt, legacy["generated_at"])
+	setIfMissing(project, "gophex_version", doc.Project.GophexVersion, legacy["gophex_version"])
+	setIfMissing(database, "type", doc.Database.Type, legacy["database_type"])
+	setIfMissing(database, "config_type", doc.Database.ConfigType, legacy["database_config"])
+
+	update := make(map[string]interface{})
+	if len(project) > 0 {
+		update["project"] = project
+	}
+	if len(database) > 0 {
+		update["database"] = database
+	}
+	if len(update) == 0 {
+		return false, nil
+	}
+
+	if err := MergeMetadata(projectPath, update); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// readLegacyGenerationFile parses the key=value pairs of a legacy generation file.
+// It returns nil when the project has no such file.
+func readLegacyGenerationFile(projectPath string) (map[string]string, error) {
+	for _, name := range legacyGenerationFiles {
+		file, err := os.Open(filepath.Join(projectPath, name))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, fmt.Errorf("failed to open %s: %w", name, err)
+		}
+		defer file.Close()
+
+		values := make(map[string]string)
+		scanner := bufio.NewScanner(file)
+		for scanner.Scan() {
+			line := strings.TrimSpace(scanner.Text())
+			if line == "" || strings.HasPrefix(line, "#") {
+				continue
+			}
+			if key, value, ok := strings.Cut(line, "="); ok {
+				values[key] = value
+			}
+		}
+		if err := scanner.Err(); err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", name, err)
+		}
+
+		return values, nil
+	}
+
+	return nil, nil
+}
+
+// fillGenerationInfo copies legacy values into info where info has no value yet
+func fillGenerationInfo(info *GenerationInfo, legacy map[string]string) {
+	fields := []struct {
+		target *string
+		key    string
+	}{
+		{&info.ProjectName, "project_name"},
+		{&info.ProjectType, "project_type"},
+		{&info.GeneratedAt, "generated_at"},
+		{&info.GophexVersion, "gophex_version"},
+		{&info.DatabaseType, "database_type"},
+		{&info.DatabaseConfig, "database_config"},
+	}
+
+	for _, field := range fields {
+		if *field.target == "" {
+			*field.target = legacy[field.key]
+		}
+	}
+}
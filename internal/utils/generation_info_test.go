@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const legacyGenerationContent = `# Gophex Generated Project Metadata
+project_name=legacyapi
+project_type=api
+generated_at=2025-01-01T00:00:00Z
+gophex_version=0.9.0
+database_type=mongodb
+database_config=cluster
+`
+
+func TestReadGenerationInfoLegacyFile(t *testing.T) {
+	for _, name := range legacyGenerationFiles {
+		t.Run(name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			if err := os.WriteFile(filepath.Join(tempDir, name), []byte(legacyGenerationContent), 0644); err != nil {
+				t.Fatalf("Failed to write legacy file: %v", err)
+			}
+
+			info, err := ReadGenerationInfo(tempDir)
+			if err != nil {
+				t.Fatalf("Failed to read generation info: %v", err)
+			}
+			if info.DatabaseType != "mongodb" {
+				t.Errorf("Expected database type 'mongodb', got '%s'", info.DatabaseType)
+			}
+			if info.GeneratedAt != "2025-01-01T00:00:00Z" {
+				t.Errorf("Expected generated_at from legacy file, got '%s'", info.GeneratedAt)
+			}
+		})
+	}
+}
+
+func TestReadGenerationInfoMissing(t *testing.T) {
+	if _, err := ReadGenerationInfo(t.TempDir()); err == nil {
+		t.Error("Expected an error when no generation metadata exists")
+	}
+}
+
+func TestConsolidateGenerationFile(t *testing.T) {
+	tempDir := t.TempDir()
+
+	metadataContent := "# Gophex Project Metadata\n\n```json\n" + `{
+  "schema_version": 1,
+  "project": {"name": "legacyapi", "type": "api", "gophex_version": "1.0.0"},
+  "database": {"configured": true, "migrations_executed": false, "schema_initialized": false},
+  "endpoints": [{"method": "GET", "path": "/health"}]
+}` + "\n```\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "gophex.md"), []byte(metadataContent), 0644); err != nil {
+		t.Fatalf("Failed to write metadata file: %v", err)
+	}
+	if err := os.WriteFile(filepath.Join(tempDir, ".gophex-generated"), []byte(legacyGenerationContent), 0644); err != nil {
+		t.Fatalf("Failed to write legacy file: %v", err)
+	}
+
+	updated, err := ConsolidateGenerationFile(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to consolidate generation file: %v", err)
+	}
+	if !updated {
+		t.Fatal("Expected gophex.md to be updated")
+	}
+
+	// Remove the legacy file so only gophex.md is consulted
+	os.Remove(filepath.Join(tempDir, ".gophex-generated"))
+
+	info, err := ReadGenerationInfo(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to read generation info: %v", err)
+	}
+	if info.DatabaseType != "mongodb" || info.DatabaseConfig != "cluster" {
+		t.Errorf("Expected database mongodb/cluster, got %s/%s", info.DatabaseType, info.DatabaseConfig)
+	}
+	if info.GophexVersion != "1.0.0" {
+		t.Errorf("Expected existing gophex_version to be kept, got '%s'", info.GophexVersion)
+	}
+
+	var doc map[string]interface{}
+	if err := LoadMetadataInto(tempDir, &doc); err != nil {
+		t.Fatalf("Failed to load metadata: %v", err)
+	}
+	if _, ok := doc["endpoints"]; !ok {
+		t.Error("Expected unrelated metadata to be preserved")
+	}
+}
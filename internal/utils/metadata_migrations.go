@@ -0,0 +1,163 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+)
+
+// CurrentSchemaVersion is the gophex.md schema version written by this release of Gophex
+const CurrentSchemaVersion = 1
+
+// metadataMigration upgrades a raw metadata document by exactly one schema version
+type metadataMigration func(doc map[string]interface{}) error
+
+// metadataMigrations is the ordered migration chain; entry i upgrades schema version i to i+1.
+// To change the metadata shape, append a migration here and bump CurrentSchemaVersion.
+var metadataMigrations = []metadataMigration{
+	migrateV0ToV1,
+}
+
+// legacyActivityNames maps the fixed activity flags of the legacy tracker to activity names
+var legacyActivityNames = map[string]string{
+	"dependencies_installed": "dependencies_installed",
+	"database_setup":         "database_migrated",
+	"application_started":    "application_started",
+	"tests_run":              "tests_executed",
+	"health_check_tested":    "health_check_tested",
+	"documentation_viewed":   "documentation_viewed",
+	"change_detection_run":   "change_detection_run",
+}
+
+// CanonicalActivityName returns the current name for an activity, translating legacy tracker names
+func CanonicalActivityName(name string) string {
+	if current, ok := legacyActivityNames[name]; ok {
+		return current
+	}
+	return name
+}
+
+// migrateV0ToV1 converts the legacy {"gophex": {...}} layout into the current layout.
+// Unversioned documents that already use the current layout are only stamped.
+func migrateV0ToV1(doc map[string]interface{}) error {
+	legacy, ok := doc["gophex"].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	delete(doc, "gophex")
+
+	legacyProject, _ := legacy["project"].(map[string]interface{})
+	if legacyProject == nil {
+		return fmt.Errorf("legacy metadata has no project section")
+	}
+
+	project := map[string]interface{}{
+		"name":         legacyProject["name"],
+		"type":         legacyProject["type"],
+		"last_updated": legacy["generated_at"],
+	}
+	if module, ok := legacyProject["module_name"]; ok {
+		project["module"] = module
+	} else if module, ok := legacyProject["module"]; ok {
+		project["module"] = module
+	}
+	if path, ok := legacyProject["path"]; ok {
+		project["path"] = path
+	}
+	doc["project"] = project
+
+	database := map[string]interface{}{
+		"migrations_executed": false,
+		"schema_initialized":  false,
+	}
+	if legacyDatabase, ok := legacy["database"].(map[string]interface{}); ok {
+		for _, key := range []string{"configured", "type", "config_type", "migrations_executed"} {
+			if value, exists := legacyDatabase[key]; exists {
+				database[key] = value
+			}
+		}
+		if initialized, exists := legacyDatabase["initialization_completed"]; exists {
+			database["schema_initialized"] = initialized
+		}
+	}
+	doc["database"] = database
+
+	if redis, ok := legacy["redis"]; ok {
+		doc["redis"] = redis
+	}
+	if hierarchy, ok := legacy["hierarchy"]; ok {
+		doc["hierarchy"] = hierarchy
+	}
+
+	activities := make(map[string]interface{})
+	if legacyActivities, ok := legacy["activities"].(map[string]interface{}); ok {
+		for legacyName, completed := range legacyActivities {
+			name, known := legacyActivityNames[legacyName]
+			if !known {
+				name = legacyName
+			}
+			done, _ := completed.(bool)
+			activities[name] = map[string]interface{}{
+				"completed":  done,
+				"can_repeat": true,
+			}
+		}
+	}
+	doc["activities"] = activities
+
+	return nil
+}
+
+// schemaVersionOf returns the schema version recorded in a raw metadata document
+func schemaVersionOf(doc map[string]interface{}) int {
+	if version, ok := doc["schema_version"].(float64); ok {
+		return int(version)
+	}
+	return 0
+}
+
+// migrateMetadataDocument applies every pending migration to doc and returns the version it started at
+func migrateMetadataDocument(doc map[string]interface{}) (int, error) {
+	from := schemaVersionOf(doc)
+	if from > CurrentSchemaVersion {
+		return from, fmt.Errorf("gophex.md uses schema version %d but this Gophex only supports up to %d - please upgrade Gophex", from, CurrentSchemaVersion)
+	}
+
+	for version := from; version < CurrentSchemaVersion; version++ {
+		if err := metadataMigrations[version](doc); err != nil {
+			return from, fmt.Errorf("failed to migrate metadata from schema version %d to %d: %w", version, version+1, err)
+		}
+		doc["schema_version"] = version + 1
+	}
+
+	return from, nil
+}
+
+// MigrateMetadataFile upgrades gophex.md in place to CurrentSchemaVersion.
+// The previous file is kept as gophex.md.v<old>.bak. It returns the version the
+// file was migrated from and whether a migration took place.
+func MigrateMetadataFile(projectPath string) (int, bool, error) {
+	doc, content, err := readMetadataDocument(projectPath)
+	if err != nil {
+		return 0, false, err
+	}
+
+	from, err := migrateMetadataDocument(doc)
+	if err != nil {
+		return from, false, err
+	}
+	if from == CurrentSchemaVersion {
+		return from, false, nil
+	}
+
+	backupPath := fmt.Sprintf("%s.v%d.bak", filepath.Join(projectPath, "gophex.md"), from)
+	if err := os.WriteFile(backupPath, content, 0644); err != nil {
+		return from, false, fmt.Errorf("failed to back up metadata file: %w", err)
+	}
+
+	if err := writeMetadataDocument(projectPath, doc); err != nil {
+		return from, false, err
+	}
+
+	return from, true, nil
+}
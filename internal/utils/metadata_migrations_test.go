@@ -0,0 +1,146 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestMigrationChainMatchesSchemaVersion(t *testing.T) {
+	if len(metadataMigrations) != CurrentSchemaVersion {
+		t.Errorf("Expected %d migrations, got %d", CurrentSchemaVersion, len(metadataMigrations))
+	}
+}
+
+func TestMigrateMetadataFile(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gophex-migration-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	// Unversioned metadata as written by earlier releases
+	original := "# Gophex Project Metadata\n\n" + "```json\n" + `{
+  "project": {
+    "name": "oldproject",
+    "type": "api",
+    "last_updated": "2025-01-01T00:00:00Z"
+  },
+  "features": {
+    "authentication": true
+  },
+  "activities": {}
+}
+` + "```\n"
+
+	metadataPath := filepath.Join(tempDir, "gophex.md")
+	if err := os.WriteFile(metadataPath, []byte(original), 0644); err != nil {
+		t.Fatalf("Failed to write metadata file: %v", err)
+	}
+
+	from, migrated, err := MigrateMetadataFile(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to migrate metadata: %v", err)
+	}
+	if !migrated || from != 0 {
+		t.Fatalf("Expected migration from version 0, got migrated=%t from=%d", migrated, from)
+	}
+
+	backup, err := os.ReadFile(metadataPath + ".v0.bak")
+	if err != nil {
+		t.Fatalf("Expected backup file: %v", err)
+	}
+	if string(backup) != original {
+		t.Error("Backup should contain the original metadata")
+	}
+
+	content, err := os.ReadFile(metadataPath)
+	if err != nil {
+		t.Fatalf("Failed to read migrated metadata: %v", err)
+	}
+	if !strings.Contains(string(content), `"authentication": true`) {
+		t.Error("Migration should preserve fields unknown to ProjectMetadata")
+	}
+
+	metadata, err := LoadMetadata(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to load migrated metadata: %v", err)
+	}
+	if metadata.SchemaVersion != CurrentSchemaVersion {
+		t.Errorf("Expected schema version %d, got %d", CurrentSchemaVersion, metadata.SchemaVersion)
+	}
+
+	// A second run must be a no-op
+	if _, migrated, err := MigrateMetadataFile(tempDir); err != nil || migrated {
+		t.Errorf("Expected no migration for current schema, got migrated=%t err=%v", migrated, err)
+	}
+}
+
+func TestLoadMetadataLegacyFormat(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gophex-migration-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	legacy := `{
+  "gophex": {
+    "version": "1.0.0",
+    "generated_at": "2025-01-01T00:00:00Z",
+    "project": {"name": "legacyproject", "type": "api", "path": "/tmp/legacyproject", "module_name": "github.com/user/legacyproject"},
+    "database": {"configured": true, "type": "postgresql", "migrations_executed": true, "initialization_completed": true},
+    "activities": {"database_setup": true, "tests_run": false}
+  }
+}`
+	if err := os.WriteFile(filepath.Join(tempDir, "gophex.md"), []byte(legacy), 0644); err != nil {
+		t.Fatalf("Failed to write metadata file: %v", err)
+	}
+
+	metadata, err := LoadMetadata(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to load legacy metadata: %v", err)
+	}
+
+	if metadata.Project.Name != "legacyproject" {
+		t.Errorf("Expected project name 'legacyproject', got '%s'", metadata.Project.Name)
+	}
+	if !metadata.Database.SchemaInitialized {
+		t.Error("Expected initialization_completed to carry over to schema_initialized")
+	}
+	if !metadata.Activities["database_migrated"].Completed {
+		t.Error("Expected legacy database_setup to map to database_migrated")
+	}
+	if metadata.Activities["tests_executed"].Completed {
+		t.Error("Expected tests_executed to remain incomplete")
+	}
+
+	// Raw legacy JSON files are rewritten in the markdown format
+	if _, migrated, err := MigrateMetadataFile(tempDir); err != nil || !migrated {
+		t.Fatalf("Expected legacy JSON file to be migrated, got migrated=%t err=%v", migrated, err)
+	}
+	content, err := os.ReadFile(filepath.Join(tempDir, "gophex.md"))
+	if err != nil {
+		t.Fatalf("Failed to read migrated metadata file: %v", err)
+	}
+	if !strings.HasPrefix(string(content), "# Gophex Project Metadata") {
+		t.Error("Expected migrated file to use the markdown format")
+	}
+}
+
+func TestLoadMetadataNewerSchema(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "gophex-migration-test-*")
+	if err != nil {
+		t.Fatalf("Failed to create temp directory: %v", err)
+	}
+	defer os.RemoveAll(tempDir)
+
+	content := "```json\n" + `{"schema_version": 999, "project": {"name": "future"}}` + "\n```\n"
+	if err := os.WriteFile(filepath.Join(tempDir, "gophex.md"), []byte(content), 0644); err != nil {
+		t.Fatalf("Failed to write metadata file: %v", err)
+	}
+
+	if _, err := LoadMetadata(tempDir); err == nil {
+		t.Error("Expected error for metadata written by a newer Gophex")
+	}
+}
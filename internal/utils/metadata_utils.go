@@ -2,13 +2,20 @@ package utils
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"os"
 	"path/filepath"
 	"strings"
 	"time"
+
+	"github.com/buildwithhp/gophex/internal/shared/failure"
 )
 
+// ProjectDirHint tells users how to point Gophex at a project when gophex.md or go.mod is missing
+const ProjectDirHint = "run Gophex inside a Gophex project directory, or pass --path to one"
+
 // GetEnvWithDefault returns the value of an environment variable or a default value if not set
 func GetEnvWithDefault(key, defaultValue string) string {
 	if value, exists := os.LookupEnv(key); exists {
@@ -26,7 +33,8 @@ type ActivityInfo struct {
 
 // ProjectMetadata represents basic project metadata structure
 type ProjectMetadata struct {
-	Project struct {
+	SchemaVersion int `json:"schema_version"`
+	Project       struct {
 		Name        string `json:"name"`
 		Type        string `json:"type"`
 		LastUpdated string `json:"last_updated"`
@@ -36,20 +44,7 @@ type ProjectMetadata struct {
 		SchemaInitialized  bool `json:"schema_initialized"`
 	} `json:"database"`
 	Activities map[string]ActivityInfo `json:"activities"`
-}
-
-// LegacyMetadata represents the old gophex.md format
-type LegacyMetadata struct {
-	Gophex struct {
-		Version     string `json:"version"`
-		GeneratedAt string `json:"generated_at"`
-		Project     struct {
-			Name   string `json:"name"`
-			Type   string `json:"type"`
-			Path   string `json:"path"`
-			Module string `json:"module"`
-		} `json:"project"`
-	} `json:"gophex"`
+	Features   map[string]bool         `json:"features,omitempty"`
 }
 
 // UpdateActivity updates the status of a specific activity in the project metadata
@@ -109,18 +104,74 @@ func GetActivityPrefix(projectPath, activityName string) string {
 	return ""
 }
 
-// LoadMetadata loads metadata from gophex.md file
+// LoadMetadata loads metadata from gophex.md file, upgrading older schema versions in memory
 func LoadMetadata(projectPath string) (*ProjectMetadata, error) {
+	var metadata ProjectMetadata
+	if err := LoadMetadataInto(projectPath, &metadata); err != nil {
+		return nil, err
+	}
+
+	if metadata.Project.Name == "" {
+		return nil, fmt.Errorf("metadata file appears to be corrupted - no project name found")
+	}
+
+	if metadata.Activities == nil {
+		metadata.Activities = make(map[string]ActivityInfo)
+	}
+
+	return &metadata, nil
+}
+
+// LoadMetadataInto decodes gophex.md into target after upgrading it to the current schema in memory.
+// It lets packages with a richer metadata model share the same parsing and migration path.
+func LoadMetadataInto(projectPath string, target interface{}) error {
+	doc, _, err := readMetadataDocument(projectPath)
+	if err != nil {
+		return err
+	}
+
+	if _, err := migrateMetadataDocument(doc); err != nil {
+		return err
+	}
+
+	migrated, err := json.Marshal(doc)
+	if err != nil {
+		return fmt.Errorf("failed to marshal migrated metadata: %w", err)
+	}
+
+	if err := json.Unmarshal(migrated, target); err != nil {
+		return fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	return nil
+}
+
+// readMetadataDocument reads gophex.md and returns its JSON document along with the raw file content
+func readMetadataDocument(projectPath string) (map[string]interface{}, []byte, error) {
 	filePath := filepath.Join(projectPath, "gophex.md")
 	content, err := os.ReadFile(filePath)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil, failure.Environment(fmt.Errorf("%s is not a Gophex project: %w", projectPath, err), ProjectDirHint)
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to read metadata file: %w", err)
+		return nil, nil, failure.Environment(fmt.Errorf("failed to read metadata file: %w", err), "")
 	}
 
-	// Extract JSON from markdown or handle legacy JSON format
-	contentStr := string(content)
-	var jsonContent string
+	jsonContent, err := extractMetadataJSON(string(content))
+	if err != nil {
+		return nil, content, err
+	}
+
+	var doc map[string]interface{}
+	if err := json.Unmarshal([]byte(jsonContent), &doc); err != nil {
+		return nil, content, fmt.Errorf("failed to unmarshal metadata: %w", err)
+	}
+
+	return doc, content, nil
+}
 
+// extractMetadataJSON extracts the JSON document from gophex.md content or handles the legacy JSON format
+func extractMetadataJSON(contentStr string) (string, error) {
 	// First, try to extract from markdown format
 	startMarkers := []string{
 		"```json\n",
@@ -136,8 +187,6 @@ func LoadMetadata(projectPath string) (*ProjectMetadata, error) {
 		"\r\n```\r\n",
 	}
 
-	var found bool
-
 	for _, startMarker := range startMarkers {
 		startIdx := strings.Index(contentStr, startMarker)
 		if startIdx == -1 {
@@ -149,79 +198,81 @@ func LoadMetadata(projectPath string) (*ProjectMetadata, error) {
 		for _, endMarker := range endMarkers {
 			endIdx := strings.Index(contentStr[jsonStart:], endMarker)
 			if endIdx != -1 {
-				jsonContent = contentStr[jsonStart : jsonStart+endIdx]
-				found = true
-				break
+				return contentStr[jsonStart : jsonStart+endIdx], nil
 			}
 		}
-
-		if found {
-			break
-		}
 	}
 
 	// If no markdown markers found, try to parse as legacy JSON format
-	if !found {
-		// Check if it looks like JSON (starts with { and contains "gophex")
-		trimmed := strings.TrimSpace(contentStr)
-		if strings.HasPrefix(trimmed, "{") && strings.Contains(trimmed, "\"gophex\"") {
-			jsonContent = trimmed
-			found = true
-		}
+	// Check if it looks like JSON (starts with { and contains "gophex")
+	trimmed := strings.TrimSpace(contentStr)
+	if strings.HasPrefix(trimmed, "{") && strings.Contains(trimmed, "\"gophex\"") {
+		return trimmed, nil
 	}
 
-	if !found {
-		preview := contentStr
-		if len(preview) > 200 {
-			preview = preview[:200]
-		}
-		return nil, fmt.Errorf("no JSON markers found and content doesn't appear to be legacy JSON format. File content preview: %q", preview)
+	preview := contentStr
+	if len(preview) > 200 {
+		preview = preview[:200]
 	}
+	return "", fmt.Errorf("no JSON markers found and content doesn't appear to be legacy JSON format. File content preview: %q", preview)
+}
 
-	// Try to parse as new format first
-	var metadata ProjectMetadata
-	err = json.Unmarshal([]byte(jsonContent), &metadata)
-	if err != nil || metadata.Project.Name == "" {
-		// If new format fails or results in empty project name, try legacy format
-		var legacyMetadata LegacyMetadata
-		err = json.Unmarshal([]byte(jsonContent), &legacyMetadata)
-		if err != nil {
-			return nil, fmt.Errorf("failed to unmarshal metadata in both new and legacy formats: %w", err)
-		}
+// SaveMetadata saves metadata to gophex.md file
+func SaveMetadata(projectPath string, metadata *ProjectMetadata) error {
+	metadata.SchemaVersion = CurrentSchemaVersion
+	return MergeMetadata(projectPath, metadata)
+}
+
+// MergeMetadata writes the fields of document into gophex.md, preserving fields the document does not know about.
+// Top-level sections named in replace are written as the document has them instead of merged, so entries
+// the document no longer has are removed. A gophex.md that exists but cannot be read or parsed is left alone.
+func MergeMetadata(projectPath string, document interface{}, replace ...string) error {
+	data, err := json.Marshal(document)
+	if err != nil {
+		return fmt.Errorf("failed to marshal metadata: %w", err)
+	}
+
+	var update map[string]interface{}
+	if err := json.Unmarshal(data, &update); err != nil {
+		return fmt.Errorf("metadata must be a JSON object: %w", err)
+	}
 
-		// Validate that we actually got legacy data
-		if legacyMetadata.Gophex.Project.Name == "" {
-			return nil, fmt.Errorf("metadata file appears to be corrupted - no project name found in either format")
+	existing, _, err := readMetadataDocument(projectPath)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		existing = make(map[string]interface{})
+	case err != nil:
+		return fmt.Errorf("gophex.md was not updated: %w", err)
+	default:
+		if _, err := migrateMetadataDocument(existing); err != nil {
+			return err
 		}
+	}
 
-		// Convert legacy format to new format
-		metadata = ProjectMetadata{
-			Project: struct {
-				Name        string `json:"name"`
-				Type        string `json:"type"`
-				LastUpdated string `json:"last_updated"`
-			}{
-				Name:        legacyMetadata.Gophex.Project.Name,
-				Type:        legacyMetadata.Gophex.Project.Type,
-				LastUpdated: legacyMetadata.Gophex.GeneratedAt,
-			},
-			Database: struct {
-				MigrationsExecuted bool `json:"migrations_executed"`
-				SchemaInitialized  bool `json:"schema_initialized"`
-			}{
-				MigrationsExecuted: false,
-				SchemaInitialized:  false,
-			},
-			Activities: make(map[string]ActivityInfo),
+	for _, section := range replace {
+		delete(existing, section)
+	}
+	mergeDocuments(existing, update)
+	return writeMetadataDocument(projectPath, existing)
+}
+
+// mergeDocuments recursively copies src into dst; nested objects are merged, everything else is replaced
+func mergeDocuments(dst, src map[string]interface{}) {
+	for key, value := range src {
+		srcMap, srcIsMap := value.(map[string]interface{})
+		dstMap, dstIsMap := dst[key].(map[string]interface{})
+		if srcIsMap && dstIsMap {
+			mergeDocuments(dstMap, srcMap)
+			continue
 		}
+		dst[key] = value
 	}
-	return &metadata, nil
 }
 
-// SaveMetadata saves metadata to gophex.md file
-func SaveMetadata(projectPath string, metadata *ProjectMetadata) error {
+// writeMetadataDocument writes any JSON-serializable metadata document to gophex.md
+func writeMetadataDocument(projectPath string, document interface{}) error {
 	// Convert to JSON with proper formatting
-	jsonData, err := json.MarshalIndent(metadata, "", "  ")
+	jsonData, err := json.MarshalIndent(document, "", "  ")
 	if err != nil {
 		return fmt.Errorf("failed to marshal metadata: %w", err)
 	}
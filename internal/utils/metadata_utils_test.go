@@ -3,6 +3,7 @@ package utils
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
 )
 
@@ -130,3 +131,80 @@ func TestMetadataWithNonExistentFile(t *testing.T) {
 		t.Errorf("Expected empty prefix for non-existent file, got: %s", prefix)
 	}
 }
+
+func TestMergeMetadataLeavesUnreadableFileAlone(t *testing.T) {
+	tests := []struct {
+		name  string
+		write func(path string) error
+	}{
+		{"malformed", func(path string) error {
+			return os.WriteFile(path, []byte("# Gophex Project Metadata\n\n```json\n{\"project\": \n```\n"), 0644)
+		}},
+		{"unreadable", func(path string) error { return os.Mkdir(path, 0755) }},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			tempDir := t.TempDir()
+			path := filepath.Join(tempDir, "gophex.md")
+			if err := test.write(path); err != nil {
+				t.Fatalf("Failed to create gophex.md: %v", err)
+			}
+			before, _ := os.ReadFile(path)
+
+			err := MergeMetadata(tempDir, map[string]interface{}{"project": map[string]interface{}{"name": "shop"}})
+			if err == nil || !strings.Contains(err.Error(), "gophex.md was not updated") {
+				t.Errorf("Expected an error leaving gophex.md unchanged, got %v", err)
+			}
+			if after, _ := os.ReadFile(path); string(after) != string(before) {
+				t.Errorf("Expected gophex.md to be unchanged, got %q", after)
+			}
+		})
+	}
+}
+
+func TestMergeMetadataReplacesSections(t *testing.T) {
+	tempDir := t.TempDir()
+
+	// A missing gophex.md starts from an empty document
+	initial := map[string]interface{}{
+		"project": map[string]interface{}{"name": "shop"},
+		"hooks":   map[string]interface{}{"post_generate": []string{"make tidy"}, "pre_start": []string{"make lint"}},
+	}
+	if err := MergeMetadata(tempDir, initial); err != nil {
+		t.Fatalf("Failed to write metadata: %v", err)
+	}
+
+	update := map[string]interface{}{"hooks": map[string]interface{}{"pre_start": []string{"make test"}}}
+	if err := MergeMetadata(tempDir, update); err != nil {
+		t.Fatalf("Failed to merge metadata: %v", err)
+	}
+	doc, _, err := readMetadataDocument(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to read metadata: %v", err)
+	}
+	if hooks := doc["hooks"].(map[string]interface{}); len(hooks) != 2 {
+		t.Errorf("Expected merging to keep both hook events, got %v", hooks)
+	}
+
+	if err := MergeMetadata(tempDir, update, "hooks"); err != nil {
+		t.Fatalf("Failed to replace hooks: %v", err)
+	}
+	doc, _, err = readMetadataDocument(tempDir)
+	if err != nil {
+		t.Fatalf("Failed to read metadata: %v", err)
+	}
+	if hooks := doc["hooks"].(map[string]interface{}); len(hooks) != 1 || hooks["pre_start"] == nil {
+		t.Errorf("Expected only the pre_start hooks after replacing the section, got %v", hooks)
+	}
+	if project := doc["project"].(map[string]interface{}); project["name"] != "shop" {
+		t.Errorf("Expected other sections to be kept, got %v", project)
+	}
+
+	if err := MergeMetadata(tempDir, map[string]interface{}{}, "hooks"); err != nil {
+		t.Fatalf("Failed to clear hooks: %v", err)
+	}
+	if doc, _, _ := readMetadataDocument(tempDir); doc["hooks"] != nil {
+		t.Errorf("Expected a replaced section missing from the document to be removed, got %v", doc["hooks"])
+	}
+}
@@ -0,0 +1,39 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/buildwithhp/gophex/internal/shared/failure"
+)
+
+// ReadModulePath returns the module path declared in the project's go.mod
+func ReadModulePath(projectPath string) (string, error) {
+	content, err := os.ReadFile(filepath.Join(projectPath, "go.mod"))
+	if err != nil {
+		return "", failure.Environment(fmt.Errorf("failed to read go.mod: %w", err), ProjectDirHint)
+	}
+
+	for _, line := range strings.Split(string(content), "\n") {
+		line = strings.TrimSpace(line)
+		if !strings.HasPrefix(line, "module ") && !strings.HasPrefix(line, "module\t") {
+			continue
+		}
+
+		path := strings.TrimSpace(strings.TrimPrefix(line, "module"))
+		if i := strings.Index(path, "//"); i >= 0 {
+			path = strings.TrimSpace(path[:i])
+		}
+		if unquoted, err := strconv.Unquote(path); err == nil {
+			path = unquoted
+		}
+		if path != "" {
+			return path, nil
+		}
+	}
+
+	return "", fmt.Errorf("module name not found in go.mod")
+}
@@ -0,0 +1,44 @@
+package utils
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReadModulePath(t *testing.T) {
+	tests := []struct {
+		name     string
+		goMod    string
+		expected string
+		wantErr  bool
+	}{
+		{"plain", "module github.com/acme/shop\n\ngo 1.22\n", "github.com/acme/shop", false},
+		{"quoted", "module \"github.com/acme/shop\"\n", "github.com/acme/shop", false},
+		{"comment", "// Shop API\nmodule shop // renamed from store\n", "shop", false},
+		{"missing", "go 1.22\n", "", true},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			projectPath := t.TempDir()
+			if err := os.WriteFile(filepath.Join(projectPath, "go.mod"), []byte(test.goMod), 0644); err != nil {
+				t.Fatalf("Failed to write go.mod: %v", err)
+			}
+
+			result, err := ReadModulePath(projectPath)
+			if test.wantErr {
+				if err == nil {
+					t.Errorf("Expected error, got module %q", result)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result != test.expected {
+				t.Errorf("Expected %q, got %q", test.expected, result)
+			}
+		})
+	}
+}
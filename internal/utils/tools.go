@@ -0,0 +1,45 @@
+package utils
+
+import "fmt"
+
+// PinnedTool is a Go command Gophex installs with go install, pinned to an exact
+// module version and its go.sum hash so every install builds the same audited source
+type PinnedTool struct {
+	Module  string // module path as recorded in the binary's build info
+	Package string // package path of the command
+	Version string
+	Sum     string // h1: hash of the module zip, as listed by sum.golang.org
+}
+
+// GolangMigrate is the golang-migrate CLI used for SQL database migrations
+var GolangMigrate = PinnedTool{
+	Module:  "github.com/golang-migrate/migrate/v4",
+	Package: "github.com/golang-migrate/migrate/v4/cmd/migrate",
+	Version: "v4.18.3",
+	Sum:     "h1:EYGkoOsvgHHfm5U/naS1RP/6PL/Xv3S4B/swMiAmDLs=",
+}
+
+// Target returns the package@version argument for go install
+func (t PinnedTool) Target() string {
+	return t.Package + "@" + t.Version
+}
+
+// InstallCommand returns the go install command line users can run themselves
+func (t PinnedTool) InstallCommand(tags string) string {
+	if tags == "" {
+		return "go install " + t.Target()
+	}
+	return fmt.Sprintf("go install -tags '%s' %s", tags, t.Target())
+}
+
+// MigrateDriverTag returns the golang-migrate build tag for a Gophex database type
+func MigrateDriverTag(dbType string) string {
+	switch dbType {
+	case "mysql":
+		return "mysql"
+	case "mongodb":
+		return "mongodb"
+	default:
+		return "postgres"
+	}
+}
@@ -0,0 +1,40 @@
+package utils
+
+import "testing"
+
+func TestPinnedToolInstallCommand(t *testing.T) {
+	tests := []struct {
+		name     string
+		tags     string
+		expected string
+	}{
+		{"without tags", "", "go install github.com/golang-migrate/migrate/v4/cmd/migrate@" + GolangMigrate.Version},
+		{"with tags", "postgres", "go install -tags 'postgres' github.com/golang-migrate/migrate/v4/cmd/migrate@" + GolangMigrate.Version},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GolangMigrate.InstallCommand(tt.tags); got != tt.expected {
+				t.Errorf("Expected %q, got %q", tt.expected, got)
+			}
+		})
+	}
+}
+
+func TestMigrateDriverTag(t *testing.T) {
+	tests := []struct {
+		dbType   string
+		expected string
+	}{
+		{"postgresql", "postgres"},
+		{"mysql", "mysql"},
+		{"mongodb", "mongodb"},
+		{"", "postgres"},
+	}
+
+	for _, tt := range tests {
+		if got := MigrateDriverTag(tt.dbType); got != tt.expected {
+			t.Errorf("Expected %s for %q, got %s", tt.expected, tt.dbType, got)
+		}
+	}
+}
@@ -5,17 +5,38 @@ import (
 	"os"
 
 	"github.com/buildwithhp/gophex/internal/cmd"
+	"github.com/buildwithhp/gophex/internal/shared/failure"
 )
 
 func main() {
+	// Global flags such as --accessible and --defaults apply to every mode
+	args, err := cmd.ParseGlobalFlags(os.Args[1:])
+	if err != nil {
+		exit(err)
+	}
+
+	// Non-interactive subcommands, e.g. "gophex inspect endpoints"
+	if len(args) > 0 {
+		if err := cmd.ExecuteCommand(args); err != nil {
+			exit(err)
+		}
+		return
+	}
+
 	// Interactive mode
 	fmt.Println("🚀 Welcome to Gophex!")
 	fmt.Println("A CLI tool for generating Go project scaffolding")
 	fmt.Println()
 
 	if err := cmd.Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
-		os.Exit(1)
+		exit(err)
 	}
 	
 }
+
+// exit reports err with its remediation hint and exits with the status for its kind:
+// 1 internal, 2 user, 3 environment, 4 template, 5 external tool
+func exit(err error) {
+	failure.Report(os.Stderr, err)
+	os.Exit(failure.ExitCode(err))
+}